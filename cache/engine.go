@@ -0,0 +1,96 @@
+package main
+
+import (
+	pb "cache/grpc"
+	"cache/lru"
+	"cache/utils"
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"path"
+)
+
+// engine picks what holds the keys. The default, sharded, supports every
+// call. The others trade features for speed and only serve plain keys and
+// values through GetKey, SetKey, Remove, Clear and Stats:
+//
+//   - clock keeps cache_capacity entries in a CLOCK ring, whose reads only
+//     take a shared lock and set a reference bit.
+//   - arena keeps cache_max_bytes of entries in byte rings the garbage
+//     collector doesn't scan, evicting close to least recently used.
+var engine = utils.GetEnv("cache_engine", "sharded")
+
+// store is the cache of an engine other than sharded.
+type store interface {
+	Get(key string) (string, bool)
+	Put(key string, value string) error
+	Remove(key string)
+	Clear()
+	Stats() lru.Stats
+}
+
+// simple is the store of the engine, or nil for the sharded engine.
+var simple store
+
+var engines = map[string]func() (store, error){
+	"clock": func() (store, error) {
+		if capacity <= 0 {
+			return nil, errors.New("the clock engine needs a cache_capacity")
+		}
+		return clockStore{lru.NewClockCache[string, string](capacity)}, nil
+	},
+	"arena": func() (store, error) {
+		if maxBytes <= 0 {
+			return nil, errors.New("the arena engine needs a cache_max_bytes")
+		}
+		return arenaStore{lru.NewArenaCache(maxBytes, shards)}, nil
+	},
+}
+
+// simpleMethods are the calls a store serves.
+var simpleMethods = map[string]bool{
+	"GetKey": true,
+	"SetKey": true,
+	"Remove": true,
+	"Clear":  true,
+	"Stats":  true,
+}
+
+// engineInterceptor turns away the calls the engine doesn't serve.
+func engineInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if method := path.Base(info.FullMethod); simple != nil && !simpleMethods[method] {
+		return nil, status.Errorf(501, "%s is not supported by the %s engine.", method, engine)
+	}
+	return handler(ctx, req)
+}
+
+// plain reports whether a SetKeyRequest only sets a key to a value, which
+// is all a store can do. Cost and recompute time are only hints and are
+// ignored.
+func plain(in *pb.SetKeyRequest) bool {
+	return in.Ttl == 0 && in.SoftTtl == 0 && in.Token == 0 && in.Sliding == nil && in.MaxIdle == nil &&
+		!in.Pinned && in.Priority == pb.Priority_NORMAL && in.Mode == pb.SetMode_ALWAYS && !in.ReturnPrevious
+}
+
+type clockStore struct {
+	*lru.ClockCache[string, string]
+}
+
+func (s clockStore) Put(key string, value string) error {
+	s.ClockCache.Put(key, value)
+	return nil
+}
+
+type arenaStore struct {
+	*lru.ArenaCache
+}
+
+func (s arenaStore) Get(key string) (string, bool) {
+	value, ok := s.ArenaCache.Get(key)
+	return string(value), ok
+}
+
+func (s arenaStore) Put(key string, value string) error {
+	return s.ArenaCache.Put(key, []byte(value))
+}
@@ -26,6 +26,24 @@ type CacheHandlerClient interface {
 	SetKey(ctx context.Context, in *SetKeyRequest, opts ...grpc.CallOption) (*SetKeyReply, error)
 	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearReply, error)
 	Remove(ctx context.Context, in *RemoveKeyRequest, opts ...grpc.CallOption) (*RemoveKeyReply, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsReply, error)
+	Lease(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*LeaseReply, error)
+	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysReply, error)
+	Pin(ctx context.Context, in *PinRequest, opts ...grpc.CallOption) (*PinReply, error)
+	Unpin(ctx context.Context, in *UnpinRequest, opts ...grpc.CallOption) (*UnpinReply, error)
+	MGet(ctx context.Context, in *MGetRequest, opts ...grpc.CallOption) (*MGetReply, error)
+	MSet(ctx context.Context, in *MSetRequest, opts ...grpc.CallOption) (*MSetReply, error)
+	MDelete(ctx context.Context, in *MDeleteRequest, opts ...grpc.CallOption) (*MDeleteReply, error)
+	CompareAndSet(ctx context.Context, in *CompareAndSetRequest, opts ...grpc.CallOption) (*CompareAndSetReply, error)
+	Incr(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*CounterReply, error)
+	IncrBy(ctx context.Context, in *IncrByRequest, opts ...grpc.CallOption) (*CounterReply, error)
+	Decr(ctx context.Context, in *DecrRequest, opts ...grpc.CallOption) (*CounterReply, error)
+	HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetReply, error)
+	HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetReply, error)
+	HMGet(ctx context.Context, in *HMGetRequest, opts ...grpc.CallOption) (*HMGetReply, error)
+	HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelReply, error)
+	HGetAll(ctx context.Context, in *HGetAllRequest, opts ...grpc.CallOption) (*HGetAllReply, error)
+	HIncrBy(ctx context.Context, in *HIncrByRequest, opts ...grpc.CallOption) (*HIncrByReply, error)
 }
 
 type cacheHandlerClient struct {
@@ -72,6 +90,168 @@ func (c *cacheHandlerClient) Remove(ctx context.Context, in *RemoveKeyRequest, o
 	return out, nil
 }
 
+func (c *cacheHandlerClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsReply, error) {
+	out := new(StatsReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) Lease(ctx context.Context, in *LeaseRequest, opts ...grpc.CallOption) (*LeaseReply, error) {
+	out := new(LeaseReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/Lease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysReply, error) {
+	out := new(ListKeysReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) Pin(ctx context.Context, in *PinRequest, opts ...grpc.CallOption) (*PinReply, error) {
+	out := new(PinReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/Pin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) Unpin(ctx context.Context, in *UnpinRequest, opts ...grpc.CallOption) (*UnpinReply, error) {
+	out := new(UnpinReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/Unpin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) MGet(ctx context.Context, in *MGetRequest, opts ...grpc.CallOption) (*MGetReply, error) {
+	out := new(MGetReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/MGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) MSet(ctx context.Context, in *MSetRequest, opts ...grpc.CallOption) (*MSetReply, error) {
+	out := new(MSetReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/MSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) MDelete(ctx context.Context, in *MDeleteRequest, opts ...grpc.CallOption) (*MDeleteReply, error) {
+	out := new(MDeleteReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/MDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) CompareAndSet(ctx context.Context, in *CompareAndSetRequest, opts ...grpc.CallOption) (*CompareAndSetReply, error) {
+	out := new(CompareAndSetReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/CompareAndSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) Incr(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*CounterReply, error) {
+	out := new(CounterReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/Incr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) IncrBy(ctx context.Context, in *IncrByRequest, opts ...grpc.CallOption) (*CounterReply, error) {
+	out := new(CounterReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/IncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) Decr(ctx context.Context, in *DecrRequest, opts ...grpc.CallOption) (*CounterReply, error) {
+	out := new(CounterReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/Decr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetReply, error) {
+	out := new(HSetReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/HSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetReply, error) {
+	out := new(HGetReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/HGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) HMGet(ctx context.Context, in *HMGetRequest, opts ...grpc.CallOption) (*HMGetReply, error) {
+	out := new(HMGetReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/HMGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelReply, error) {
+	out := new(HDelReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/HDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) HGetAll(ctx context.Context, in *HGetAllRequest, opts ...grpc.CallOption) (*HGetAllReply, error) {
+	out := new(HGetAllReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/HGetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheHandlerClient) HIncrBy(ctx context.Context, in *HIncrByRequest, opts ...grpc.CallOption) (*HIncrByReply, error) {
+	out := new(HIncrByReply)
+	err := c.cc.Invoke(ctx, "/cache.CacheHandler/HIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CacheHandlerServer is the server API for CacheHandler service.
 // All implementations must embed UnimplementedCacheHandlerServer
 // for forward compatibility
@@ -80,6 +260,24 @@ type CacheHandlerServer interface {
 	SetKey(context.Context, *SetKeyRequest) (*SetKeyReply, error)
 	Clear(context.Context, *ClearRequest) (*ClearReply, error)
 	Remove(context.Context, *RemoveKeyRequest) (*RemoveKeyReply, error)
+	Stats(context.Context, *StatsRequest) (*StatsReply, error)
+	Lease(context.Context, *LeaseRequest) (*LeaseReply, error)
+	ListKeys(context.Context, *ListKeysRequest) (*ListKeysReply, error)
+	Pin(context.Context, *PinRequest) (*PinReply, error)
+	Unpin(context.Context, *UnpinRequest) (*UnpinReply, error)
+	MGet(context.Context, *MGetRequest) (*MGetReply, error)
+	MSet(context.Context, *MSetRequest) (*MSetReply, error)
+	MDelete(context.Context, *MDeleteRequest) (*MDeleteReply, error)
+	CompareAndSet(context.Context, *CompareAndSetRequest) (*CompareAndSetReply, error)
+	Incr(context.Context, *IncrRequest) (*CounterReply, error)
+	IncrBy(context.Context, *IncrByRequest) (*CounterReply, error)
+	Decr(context.Context, *DecrRequest) (*CounterReply, error)
+	HSet(context.Context, *HSetRequest) (*HSetReply, error)
+	HGet(context.Context, *HGetRequest) (*HGetReply, error)
+	HMGet(context.Context, *HMGetRequest) (*HMGetReply, error)
+	HDel(context.Context, *HDelRequest) (*HDelReply, error)
+	HGetAll(context.Context, *HGetAllRequest) (*HGetAllReply, error)
+	HIncrBy(context.Context, *HIncrByRequest) (*HIncrByReply, error)
 	mustEmbedUnimplementedCacheHandlerServer()
 }
 
@@ -99,6 +297,60 @@ func (UnimplementedCacheHandlerServer) Clear(context.Context, *ClearRequest) (*C
 func (UnimplementedCacheHandlerServer) Remove(context.Context, *RemoveKeyRequest) (*RemoveKeyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
 }
+func (UnimplementedCacheHandlerServer) Stats(context.Context, *StatsRequest) (*StatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedCacheHandlerServer) Lease(context.Context, *LeaseRequest) (*LeaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lease not implemented")
+}
+func (UnimplementedCacheHandlerServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (UnimplementedCacheHandlerServer) Pin(context.Context, *PinRequest) (*PinReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pin not implemented")
+}
+func (UnimplementedCacheHandlerServer) Unpin(context.Context, *UnpinRequest) (*UnpinReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpin not implemented")
+}
+func (UnimplementedCacheHandlerServer) MGet(context.Context, *MGetRequest) (*MGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MGet not implemented")
+}
+func (UnimplementedCacheHandlerServer) MSet(context.Context, *MSetRequest) (*MSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MSet not implemented")
+}
+func (UnimplementedCacheHandlerServer) MDelete(context.Context, *MDeleteRequest) (*MDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MDelete not implemented")
+}
+func (UnimplementedCacheHandlerServer) CompareAndSet(context.Context, *CompareAndSetRequest) (*CompareAndSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndSet not implemented")
+}
+func (UnimplementedCacheHandlerServer) Incr(context.Context, *IncrRequest) (*CounterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Incr not implemented")
+}
+func (UnimplementedCacheHandlerServer) IncrBy(context.Context, *IncrByRequest) (*CounterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrBy not implemented")
+}
+func (UnimplementedCacheHandlerServer) Decr(context.Context, *DecrRequest) (*CounterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decr not implemented")
+}
+func (UnimplementedCacheHandlerServer) HSet(context.Context, *HSetRequest) (*HSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HSet not implemented")
+}
+func (UnimplementedCacheHandlerServer) HGet(context.Context, *HGetRequest) (*HGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGet not implemented")
+}
+func (UnimplementedCacheHandlerServer) HMGet(context.Context, *HMGetRequest) (*HMGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMGet not implemented")
+}
+func (UnimplementedCacheHandlerServer) HDel(context.Context, *HDelRequest) (*HDelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HDel not implemented")
+}
+func (UnimplementedCacheHandlerServer) HGetAll(context.Context, *HGetAllRequest) (*HGetAllReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGetAll not implemented")
+}
+func (UnimplementedCacheHandlerServer) HIncrBy(context.Context, *HIncrByRequest) (*HIncrByReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HIncrBy not implemented")
+}
 func (UnimplementedCacheHandlerServer) mustEmbedUnimplementedCacheHandlerServer() {}
 
 // UnsafeCacheHandlerServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +436,330 @@ func _CacheHandler_Remove_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CacheHandler_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_Lease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).Lease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/Lease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).Lease(ctx, req.(*LeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).ListKeys(ctx, req.(*ListKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_Pin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).Pin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/Pin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).Pin(ctx, req.(*PinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_Unpin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).Unpin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/Unpin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).Unpin(ctx, req.(*UnpinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_MGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).MGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/MGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).MGet(ctx, req.(*MGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_MSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).MSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/MSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).MSet(ctx, req.(*MSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_MDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).MDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/MDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).MDelete(ctx, req.(*MDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_CompareAndSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).CompareAndSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/CompareAndSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).CompareAndSet(ctx, req.(*CompareAndSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_Incr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).Incr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/Incr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).Incr(ctx, req.(*IncrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_IncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).IncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/IncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).IncrBy(ctx, req.(*IncrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_Decr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).Decr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/Decr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).Decr(ctx, req.(*DecrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_HSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).HSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/HSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).HSet(ctx, req.(*HSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_HGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).HGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/HGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).HGet(ctx, req.(*HGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_HMGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HMGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).HMGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/HMGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).HMGet(ctx, req.(*HMGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_HDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).HDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/HDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).HDel(ctx, req.(*HDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_HGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).HGetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/HGetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).HGetAll(ctx, req.(*HGetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheHandler_HIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HIncrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheHandlerServer).HIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cache.CacheHandler/HIncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheHandlerServer).HIncrBy(ctx, req.(*HIncrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CacheHandler_ServiceDesc is the grpc.ServiceDesc for CacheHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +783,78 @@ var CacheHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Remove",
 			Handler:    _CacheHandler_Remove_Handler,
 		},
+		{
+			MethodName: "Stats",
+			Handler:    _CacheHandler_Stats_Handler,
+		},
+		{
+			MethodName: "Lease",
+			Handler:    _CacheHandler_Lease_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _CacheHandler_ListKeys_Handler,
+		},
+		{
+			MethodName: "Pin",
+			Handler:    _CacheHandler_Pin_Handler,
+		},
+		{
+			MethodName: "Unpin",
+			Handler:    _CacheHandler_Unpin_Handler,
+		},
+		{
+			MethodName: "MGet",
+			Handler:    _CacheHandler_MGet_Handler,
+		},
+		{
+			MethodName: "MSet",
+			Handler:    _CacheHandler_MSet_Handler,
+		},
+		{
+			MethodName: "MDelete",
+			Handler:    _CacheHandler_MDelete_Handler,
+		},
+		{
+			MethodName: "CompareAndSet",
+			Handler:    _CacheHandler_CompareAndSet_Handler,
+		},
+		{
+			MethodName: "Incr",
+			Handler:    _CacheHandler_Incr_Handler,
+		},
+		{
+			MethodName: "IncrBy",
+			Handler:    _CacheHandler_IncrBy_Handler,
+		},
+		{
+			MethodName: "Decr",
+			Handler:    _CacheHandler_Decr_Handler,
+		},
+		{
+			MethodName: "HSet",
+			Handler:    _CacheHandler_HSet_Handler,
+		},
+		{
+			MethodName: "HGet",
+			Handler:    _CacheHandler_HGet_Handler,
+		},
+		{
+			MethodName: "HMGet",
+			Handler:    _CacheHandler_HMGet_Handler,
+		},
+		{
+			MethodName: "HDel",
+			Handler:    _CacheHandler_HDel_Handler,
+		},
+		{
+			MethodName: "HGetAll",
+			Handler:    _CacheHandler_HGetAll_Handler,
+		},
+		{
+			MethodName: "HIncrBy",
+			Handler:    _CacheHandler_HIncrBy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/cache.proto",
@@ -0,0 +1,118 @@
+package lru
+
+// ARC is the Adaptive Replacement Cache policy. Resident keys are split
+// between T1, keys seen once recently, and T2, keys seen at least twice.
+// The ghost lists B1 and B2 remember keys recently evicted from each of
+// them. A miss on a B1 ghost means T1 was too small, so the target size p
+// of T1 grows; a miss on a B2 ghost shrinks it. The balance between
+// recency and frequency follows the workload without any tuning.
+//
+// The cache asks for a victim before it inserts the key that missed, so p
+// is adapted one eviction later than in the paper. The capacity c is the
+// largest number of keys the policy has held, which is the cache capacity
+// once it has filled up and works for byte bounded caches as well.
+type ARC[K comparable] struct {
+	t1, t2 keyList[K]
+	b1, b2 keyList[K]
+	p      int
+	c      int
+	// victim is the last key handed out by Victim. When the cache removes
+	// it, it is an eviction and the key is kept as a ghost; any other
+	// removal forgets the key completely.
+	victim    K
+	hasVictim bool
+}
+
+func NewARC[K comparable]() Policy[K] {
+	return &ARC[K]{
+		t1: newKeyList[K](),
+		t2: newKeyList[K](),
+		b1: newKeyList[K](),
+		b2: newKeyList[K](),
+	}
+}
+
+func (policy *ARC[K]) Insert(key K) {
+	switch {
+	case policy.b1.remove(key):
+		policy.p = min(policy.c, policy.p+max(1, policy.b2.len()/max(1, policy.b1.len())))
+		policy.t2.pushFront(key)
+	case policy.b2.remove(key):
+		policy.p = max(0, policy.p-max(1, policy.b1.len()/max(1, policy.b2.len())))
+		policy.t2.pushFront(key)
+	default:
+		policy.t1.pushFront(key)
+	}
+
+	if resident := policy.t1.len() + policy.t2.len(); resident > policy.c {
+		policy.c = resident
+	}
+	policy.trimGhosts()
+}
+
+func (policy *ARC[K]) Access(key K) {
+	if policy.t1.remove(key) {
+		policy.t2.pushFront(key)
+	} else {
+		policy.t2.moveToFront(key)
+	}
+}
+
+func (policy *ARC[K]) Victim() (K, bool) {
+	victim, ok := policy.t2.back()
+	if policy.t1.len() > 0 && (policy.t1.len() > policy.p || !ok) {
+		victim, ok = policy.t1.back()
+	}
+	policy.victim, policy.hasVictim = victim, ok
+	return victim, ok
+}
+
+func (policy *ARC[K]) Remove(key K) {
+	evicted := policy.hasVictim && key == policy.victim
+	policy.hasVictim = false
+
+	switch {
+	case policy.t1.remove(key):
+		if evicted {
+			policy.b1.pushFront(key)
+		}
+	case policy.t2.remove(key):
+		if evicted {
+			policy.b2.pushFront(key)
+		}
+	}
+	policy.trimGhosts()
+}
+
+func (policy *ARC[K]) Clear() {
+	policy.t1.clear()
+	policy.t2.clear()
+	policy.b1.clear()
+	policy.b2.clear()
+	policy.p = 0
+	policy.c = 0
+	policy.hasVictim = false
+}
+
+// Segments reports the resident lists T1 and T2 and the ghost lists B1 and
+// B2. Ghosts hold no values, so they don't count towards the cache size.
+func (policy *ARC[K]) Segments() map[string]int {
+	return map[string]int{
+		"t1": policy.t1.len(),
+		"t2": policy.t2.len(),
+		"b1": policy.b1.len(),
+		"b2": policy.b2.len(),
+	}
+}
+
+// trimGhosts keeps T1 and B1 within c keys and all four lists within 2c.
+func (policy *ARC[K]) trimGhosts() {
+	for policy.b1.len() > 0 && policy.t1.len()+policy.b1.len() > policy.c {
+		ghost, _ := policy.b1.back()
+		policy.b1.remove(ghost)
+	}
+	for policy.b2.len() > 0 && policy.t1.len()+policy.t2.len()+policy.b1.len()+policy.b2.len() > 2*policy.c {
+		ghost, _ := policy.b2.back()
+		policy.b2.remove(ghost)
+	}
+}
@@ -0,0 +1,92 @@
+package lru
+
+import "testing"
+
+// evictOne evicts the victim of policy the way the cache does.
+func evictOne[K comparable](t *testing.T, policy Policy[K]) K {
+	t.Helper()
+	victim, ok := policy.Victim()
+	if !ok {
+		t.Fatal("Victim() found nothing to evict")
+	}
+	policy.Remove(victim)
+	return victim
+}
+
+func TestARCOnlyEvictionsLeaveGhosts(t *testing.T) {
+	policy := NewARC[string]().(*ARC[string])
+	policy.Insert("a")
+	policy.Insert("b")
+	policy.Insert("c")
+
+	policy.Remove("b")
+	if policy.b1.len() != 0 {
+		t.Fatal("a removal left a ghost")
+	}
+	// A removal between Victim and the eviction is not the eviction.
+	if victim, _ := policy.Victim(); victim != "a" {
+		t.Fatalf("Victim() = %q, want a", victim)
+	}
+	policy.Remove("c")
+	policy.Remove("a")
+	if policy.b1.len() != 0 {
+		t.Fatal("a removal after Victim left a ghost")
+	}
+
+	policy.Insert("d")
+	policy.Insert("e")
+	if victim := evictOne(t, policy); victim != "d" || !policy.b1.contains("d") {
+		t.Fatalf("evicted %q, want d kept as a ghost in B1", victim)
+	}
+}
+
+func TestARCAdaptsToGhostHits(t *testing.T) {
+	policy := NewARC[string]().(*ARC[string])
+	policy.Insert("a")
+	policy.Insert("b")
+	evictOne(t, policy)
+
+	// a was evicted from T1 too early, so T1 should be larger.
+	policy.Insert("a")
+	if policy.p != 1 || !policy.t2.contains("a") {
+		t.Fatalf("p = %d after a B1 hit, want 1 and a in T2", policy.p)
+	}
+
+	if victim := evictOne(t, policy); victim != "a" || !policy.b2.contains("a") {
+		t.Fatalf("evicted %q, want a from T2 kept as a ghost in B2", victim)
+	}
+	// a was evicted from T2 too early, so T1 should be smaller.
+	policy.Insert("a")
+	if policy.p != 0 || !policy.t2.contains("a") {
+		t.Fatalf("p = %d after a B2 hit, want 0 and a in T2", policy.p)
+	}
+}
+
+func TestARCBoundsGhosts(t *testing.T) {
+	policy := NewARC[int]().(*ARC[int])
+	for key := range 10 {
+		policy.Insert(key)
+	}
+	for key := 10; key < 200; key++ {
+		evictOne(t, policy)
+		// Bring back some ghosts and read some keys, so all lists fill.
+		ghosts := &policy.b1
+		if key%2 == 0 {
+			ghosts = &policy.b2
+		}
+		if ghost, ok := ghosts.back(); ok && key%3 == 0 {
+			policy.Insert(ghost)
+		} else {
+			policy.Insert(key)
+		}
+		policy.Access(key - 1)
+
+		t1, t2, b1, b2 := policy.t1.len(), policy.t2.len(), policy.b1.len(), policy.b2.len()
+		if t1+t2 != 10 || t1+b1 > policy.c || t1+t2+b1+b2 > 2*policy.c {
+			t.Fatalf("after key %d: T1 %d, T2 %d, B1 %d, B2 %d for c = %d", key, t1, t2, b1, b2, policy.c)
+		}
+	}
+	if policy.b1.len()+policy.b2.len() == 0 {
+		t.Fatal("no ghosts were kept")
+	}
+}
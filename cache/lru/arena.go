@@ -0,0 +1,305 @@
+package lru
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"slices"
+	"sync"
+)
+
+// arenaHeaderSize is the size of the header in front of every entry in an
+// arena: key length (2 bytes), value length (4 bytes) and key hash (8 bytes).
+const arenaHeaderSize = 14
+
+// ArenaCache keeps serialized entries in large preallocated byte rings, in
+// the style of bigcache and freecache. The index from key hash to ring
+// offset is a map of integers, so however many entries the cache holds, the
+// garbage collector has no pointers to scan besides the rings themselves.
+//
+// Entries are appended at the head of the ring of their shard and evicted
+// from its tail. A read of an entry in the older half of the ring copies it
+// back to the head, so entries that are in use are not evicted and the
+// order of eviction stays close to least-recently-used, as in Cache.
+// Replaced and removed entries leave dead bytes behind that are reclaimed
+// when the tail passes them.
+//
+// Keys are indexed by their hash. The rare keys whose hashes collide are
+// kept in a short chain per hash and told apart by the key stored with each
+// entry, so they never replace each other.
+//
+// ArenaCache is a separate cache, not a storage backend of Cache. It holds
+// string keys and byte values only, and its eviction order is fixed: it
+// doesn't use the eviction policies, and it has no TTLs, pins, priorities,
+// eviction callbacks or entry count bound. The byte budget counts headers,
+// keys and values, and it is split across the shards, each evicting on its
+// own. Use it when the garbage collector pauses of a very large Cache
+// matter more than those features.
+type ArenaCache struct {
+	shards []*arenaShard
+	// hash hashes keys; tests replace it to force collisions.
+	hash func(key string) uint64
+}
+
+type arenaShard struct {
+	sync.Mutex
+	ring []byte
+	// head and tail are logical positions that only grow; the byte at
+	// position p is ring[p%len(ring)]. Live and dead entries fill
+	// [tail, head).
+	head, tail uint64
+	// index maps the hash of a live key to the position of its entry, and
+	// collisions the hashes shared by several live keys to the positions
+	// of the others. entries counts the live keys.
+	index      map[uint64]uint64
+	collisions map[uint64][]uint64
+	entries    int
+	stats      Stats
+}
+
+// NewArenaCache splits maxBytes of ring buffers across the given number of
+// shards. An entry, including its header and key, has to fit in one shard.
+func NewArenaCache(maxBytes int64, shards int) *ArenaCache {
+	shards = max(shards, 1)
+	seed := maphash.MakeSeed()
+	cache := &ArenaCache{
+		shards: make([]*arenaShard, shards),
+		hash:   func(key string) uint64 { return maphash.String(seed, key) },
+	}
+	for i := range cache.shards {
+		cache.shards[i] = &arenaShard{
+			ring:       make([]byte, split(maxBytes, shards, i)),
+			index:      make(map[uint64]uint64),
+			collisions: make(map[uint64][]uint64),
+		}
+	}
+	return cache
+}
+
+func (cache *ArenaCache) shardFor(hash uint64) *arenaShard {
+	return cache.shards[hash%uint64(len(cache.shards))]
+}
+
+// Get returns a copy of the value stored for key.
+func (cache *ArenaCache) Get(key string) ([]byte, bool) {
+	hash := cache.hash(key)
+	s := cache.shardFor(hash)
+	s.Lock()
+	defer s.Unlock()
+
+	position, ok := s.find(hash, key)
+	if !ok {
+		s.stats.Misses++
+		return nil, false
+	}
+	s.stats.Hits++
+	value := s.value(position)
+
+	if s.head-position > uint64(len(s.ring))/2 {
+		s.put(hash, key, value)
+	}
+	return value, true
+}
+
+func (cache *ArenaCache) Put(key string, value []byte) error {
+	if len(key) > 0xffff {
+		return fmt.Errorf("lru: key of %d bytes is longer than 65535 bytes", len(key))
+	}
+	hash := cache.hash(key)
+	s := cache.shardFor(hash)
+	s.Lock()
+	defer s.Unlock()
+
+	size := arenaHeaderSize + len(key) + len(value)
+	if size > len(s.ring) {
+		return fmt.Errorf("%w: %d bytes, budget is %d bytes", ErrEntryTooLarge, size, len(s.ring))
+	}
+	s.put(hash, key, value)
+	return nil
+}
+
+func (cache *ArenaCache) Remove(key string) {
+	hash := cache.hash(key)
+	s := cache.shardFor(hash)
+	s.Lock()
+	defer s.Unlock()
+
+	if position, ok := s.find(hash, key); ok {
+		s.unlink(hash, position)
+	}
+}
+
+func (cache *ArenaCache) Clear() {
+	for _, s := range cache.shards {
+		s.Lock()
+		s.head, s.tail = 0, 0
+		s.index = make(map[uint64]uint64)
+		s.collisions = make(map[uint64][]uint64)
+		s.entries = 0
+		s.Unlock()
+	}
+}
+
+func (cache *ArenaCache) Len() int {
+	total := 0
+	for _, s := range cache.shards {
+		s.Lock()
+		total += s.entries
+		s.Unlock()
+	}
+	return total
+}
+
+// Stats adds up the statistics of all shards. Bytes counts the part of the
+// rings in use, including dead entries that have not been reclaimed yet.
+func (cache *ArenaCache) Stats() Stats {
+	var total Stats
+	for _, s := range cache.shards {
+		s.Lock()
+		total.Hits += s.stats.Hits
+		total.Misses += s.stats.Misses
+		total.Evictions += s.stats.Evictions
+		total.Entries += s.entries
+		total.Bytes += int64(s.head - s.tail)
+		s.Unlock()
+	}
+	return total
+}
+
+// put appends the entry at the head, evicting from the tail until it fits.
+// The caller has checked that the entry fits in the ring.
+func (s *arenaShard) put(hash uint64, key string, value []byte) {
+	// Any older copy is dead from now on, so reclaiming it at the tail
+	// doesn't count as an eviction.
+	if position, ok := s.find(hash, key); ok {
+		s.unlink(hash, position)
+	}
+
+	size := uint64(arenaHeaderSize + len(key) + len(value))
+	for s.head-s.tail+size > uint64(len(s.ring)) {
+		s.evictTail()
+	}
+
+	var header [arenaHeaderSize]byte
+	binary.LittleEndian.PutUint16(header[0:], uint16(len(key)))
+	binary.LittleEndian.PutUint32(header[2:], uint32(len(value)))
+	binary.LittleEndian.PutUint64(header[6:], hash)
+
+	position := s.head
+	s.write(position, header[:])
+	s.writeString(position+arenaHeaderSize, key)
+	s.write(position+arenaHeaderSize+uint64(len(key)), value)
+	s.head += size
+	s.link(hash, position)
+}
+
+// find returns the position of the live entry for key.
+func (s *arenaShard) find(hash uint64, key string) (uint64, bool) {
+	position, ok := s.index[hash]
+	if !ok {
+		return 0, false
+	}
+	if s.hasKey(position, key) {
+		return position, true
+	}
+	for _, position := range s.collisions[hash] {
+		if s.hasKey(position, key) {
+			return position, true
+		}
+	}
+	return 0, false
+}
+
+// link indexes the live entry at position, and unlink drops it from the
+// index, which makes it dead.
+func (s *arenaShard) link(hash uint64, position uint64) {
+	if _, ok := s.index[hash]; ok {
+		s.collisions[hash] = append(s.collisions[hash], position)
+	} else {
+		s.index[hash] = position
+	}
+	s.entries++
+}
+
+func (s *arenaShard) unlink(hash uint64, position uint64) {
+	chain := s.collisions[hash]
+	if s.index[hash] == position {
+		if len(chain) == 0 {
+			delete(s.index, hash)
+			s.entries--
+			return
+		}
+		s.index[hash] = chain[len(chain)-1]
+		chain = chain[:len(chain)-1]
+	} else {
+		i := slices.Index(chain, position)
+		chain = slices.Delete(chain, i, i+1)
+	}
+	if len(chain) == 0 {
+		delete(s.collisions, hash)
+	} else {
+		s.collisions[hash] = chain
+	}
+	s.entries--
+}
+
+// live reports whether the entry at position is indexed.
+func (s *arenaShard) live(hash uint64, position uint64) bool {
+	indexed, ok := s.index[hash]
+	return ok && (indexed == position || slices.Contains(s.collisions[hash], position))
+}
+
+// evictTail drops the entry at the tail. It only counts as an eviction if
+// the entry is still live; otherwise it was already dead.
+func (s *arenaShard) evictTail() {
+	var header [arenaHeaderSize]byte
+	s.read(s.tail, header[:])
+	hash := binary.LittleEndian.Uint64(header[6:])
+	if s.live(hash, s.tail) {
+		s.unlink(hash, s.tail)
+		s.stats.Evictions++
+	}
+	s.tail += arenaHeaderSize + uint64(binary.LittleEndian.Uint16(header[0:])) + uint64(binary.LittleEndian.Uint32(header[2:]))
+}
+
+// hasKey compares the key stored at position with key in place.
+func (s *arenaShard) hasKey(position uint64, key string) bool {
+	var header [arenaHeaderSize]byte
+	s.read(position, header[:])
+	if int(binary.LittleEndian.Uint16(header[0:])) != len(key) {
+		return false
+	}
+
+	start := int((position + arenaHeaderSize) % uint64(len(s.ring)))
+	n := min(len(key), len(s.ring)-start)
+	return string(s.ring[start:start+n]) == key[:n] && string(s.ring[:len(key)-n]) == key[n:]
+}
+
+func (s *arenaShard) value(position uint64) []byte {
+	var header [arenaHeaderSize]byte
+	s.read(position, header[:])
+	keyLength := uint64(binary.LittleEndian.Uint16(header[0:]))
+	value := make([]byte, binary.LittleEndian.Uint32(header[2:]))
+	s.read(position+arenaHeaderSize+keyLength, value)
+	return value
+}
+
+// read and write copy bytes at a logical position, wrapping around the end
+// of the ring.
+func (s *arenaShard) read(position uint64, into []byte) {
+	start := int(position % uint64(len(s.ring)))
+	n := copy(into, s.ring[start:])
+	copy(into[n:], s.ring)
+}
+
+func (s *arenaShard) write(position uint64, data []byte) {
+	start := int(position % uint64(len(s.ring)))
+	n := copy(s.ring[start:], data)
+	copy(s.ring, data[n:])
+}
+
+func (s *arenaShard) writeString(position uint64, data string) {
+	start := int(position % uint64(len(s.ring)))
+	n := copy(s.ring[start:], data)
+	copy(s.ring, data[n:])
+}
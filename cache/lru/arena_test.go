@@ -0,0 +1,96 @@
+package lru
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestArenaCachePutGetRemove(t *testing.T) {
+	cache := NewArenaCache(1<<10, 2)
+	if err := cache.Put("notes:1", []byte("first")); err != nil {
+		t.Fatal(err)
+	}
+	cache.Put("notes:1", []byte("second"))
+	if value, ok := cache.Get("notes:1"); !ok || string(value) != "second" {
+		t.Fatalf("Get = %q, %v, want second", value, ok)
+	}
+	cache.Remove("notes:1")
+	if _, ok := cache.Get("notes:1"); ok || cache.Len() != 0 {
+		t.Fatal("notes:1 was found after Remove")
+	}
+}
+
+func TestArenaCacheKeepsEntriesInUse(t *testing.T) {
+	size := arenaHeaderSize + len("notes:0") + len("value")
+	cache := NewArenaCache(int64(4*size), 1)
+	for i := range 4 {
+		cache.Put("notes:"+strconv.Itoa(i), []byte("value"))
+	}
+	// notes:0 is at the tail; reading it copies it back to the head.
+	cache.Get("notes:0")
+	cache.Put("notes:4", []byte("value"))
+	cache.Put("notes:5", []byte("value"))
+
+	if _, ok := cache.Get("notes:0"); !ok {
+		t.Fatal("notes:0 was evicted after it was read")
+	}
+	if _, ok := cache.Get("notes:1"); ok {
+		t.Fatal("notes:1 was not evicted")
+	}
+	if stats := cache.Stats(); stats.Bytes > int64(4*size) {
+		t.Fatalf("Stats().Bytes = %d, budget is %d", stats.Bytes, 4*size)
+	}
+}
+
+func TestArenaCacheRejectsEntryLargerThanShard(t *testing.T) {
+	cache := NewArenaCache(64, 2)
+	if err := cache.Put("notes:1", make([]byte, 32)); !errors.Is(err, ErrEntryTooLarge) {
+		t.Fatalf("Put = %v, want ErrEntryTooLarge", err)
+	}
+}
+
+func TestArenaCacheKeepsCollidingKeysApart(t *testing.T) {
+	cache := NewArenaCache(1<<10, 1)
+	cache.hash = func(string) uint64 { return 7 }
+	for i := range 3 {
+		cache.Put("notes:"+strconv.Itoa(i), []byte("value "+strconv.Itoa(i)))
+	}
+	cache.Put("notes:1", []byte("replaced"))
+	if cache.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", cache.Len())
+	}
+	for key, want := range map[string]string{"notes:0": "value 0", "notes:1": "replaced", "notes:2": "value 2"} {
+		if value, ok := cache.Get(key); !ok || string(value) != want {
+			t.Fatalf("Get(%s) = %q, %v, want %s", key, value, ok, want)
+		}
+	}
+
+	cache.Remove("notes:0")
+	if _, ok := cache.Get("notes:0"); ok {
+		t.Fatal("notes:0 was found after Remove")
+	}
+	if _, ok := cache.Get("notes:2"); !ok || cache.Len() != 2 {
+		t.Fatal("removing notes:0 dropped a colliding key")
+	}
+}
+
+func TestArenaCacheEvictsCollidingKeys(t *testing.T) {
+	size := arenaHeaderSize + len("notes:0") + len("value")
+	cache := NewArenaCache(int64(3*size), 1)
+	cache.hash = func(string) uint64 { return 7 }
+	for i := range 4 {
+		cache.Put("notes:"+strconv.Itoa(i), []byte("value"))
+	}
+	if _, ok := cache.Get("notes:0"); ok {
+		t.Fatal("notes:0 was not evicted")
+	}
+	for _, key := range []string{"notes:1", "notes:2", "notes:3"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Fatalf("%s was evicted", key)
+		}
+	}
+	if stats := cache.Stats(); stats.Evictions != 1 || stats.Entries != 3 {
+		t.Fatalf("Stats() = %+v, want 1 eviction and 3 entries", stats)
+	}
+}
@@ -0,0 +1,84 @@
+package lru
+
+import "slices"
+
+// Entry is a key and value to store with MSet.
+type Entry[K comparable, V any] struct {
+	Key     K
+	Value   V
+	Options PutOptions
+}
+
+// lockShards locks the shards that hold keys and returns a function that
+// unlocks them and then runs the eviction callbacks. Shards are locked in
+// index order, so batches that share shards cannot deadlock, and other
+// operations see a batch either before or after it was applied, never
+// halfway.
+func (sharded *Sharded[K, V]) lockShards(keys []K) (unlock func()) {
+	indexes := make([]int, len(keys))
+	for i, key := range keys {
+		indexes[i] = sharded.shardIndex(key)
+	}
+	slices.Sort(indexes)
+	indexes = slices.Compact(indexes)
+
+	for _, i := range indexes {
+		sharded.shards[i].Lock()
+	}
+	return func() {
+		// The callbacks run only once every shard is unlocked, since they
+		// may use keys in any of them.
+		afters := make([]afterUnlock[K, V], len(indexes))
+		for j, i := range indexes {
+			afters[j] = sharded.shards[i].release()
+		}
+		for _, after := range afters {
+			after.run()
+		}
+	}
+}
+
+// MGet looks up all keys at once. found[i] reports whether values[i] holds
+// the value for keys[i].
+func (sharded *Sharded[K, V]) MGet(keys []K) (values []V, found []bool) {
+	unlock := sharded.lockShards(keys)
+	defer unlock()
+
+	values, found = make([]V, len(keys)), make([]bool, len(keys))
+	for i, key := range keys {
+		values[i], found[i] = sharded.shardFor(key).cache.Get(key)
+	}
+	return values, found
+}
+
+// MSet stores all entries at once, in order, and returns the version and
+// error of each put. A failed put doesn't stop the others.
+func (sharded *Sharded[K, V]) MSet(entries []Entry[K, V]) (versions []uint64, errs []error) {
+	keys := make([]K, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.Key
+	}
+	unlock := sharded.lockShards(keys)
+	defer unlock()
+
+	versions, errs = make([]uint64, len(entries)), make([]error, len(entries))
+	for i, entry := range entries {
+		versions[i], errs[i] = sharded.shardFor(entry.Key).cache.PutWithOptions(entry.Key, entry.Value, entry.Options)
+	}
+	return versions, errs
+}
+
+// MDelete removes all keys at once. removed[i] reports whether keys[i] was
+// cached.
+func (sharded *Sharded[K, V]) MDelete(keys []K) (removed []bool) {
+	unlock := sharded.lockShards(keys)
+	defer unlock()
+
+	removed = make([]bool, len(keys))
+	for i, key := range keys {
+		cache := &sharded.shardFor(key).cache
+		removed[i] = cache.Contains(key)
+		cache.Remove(key)
+	}
+	return removed
+}
@@ -0,0 +1,55 @@
+package lru
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+// keysByShard returns n keys that fall in each shard of cache.
+func keysByShard[V any](cache *Sharded[string, V], n int) [][]string {
+	keys := make([][]string, len(cache.shards))
+	for i := 0; ; i++ {
+		key := "notes:" + strconv.Itoa(i)
+		if shard := cache.shardIndex(key); len(keys[shard]) < n {
+			keys[shard] = append(keys[shard], key)
+		}
+		full := true
+		for _, shardKeys := range keys {
+			full = full && len(shardKeys) == n
+		}
+		if full {
+			return keys
+		}
+	}
+}
+
+func TestBatchCallbacksRunAfterEveryShardIsUnlocked(t *testing.T) {
+	cache := NewSharded[string, int](4, 4)
+	keys := keysByShard(cache, 2)
+	for _, shardKeys := range keys {
+		cache.Put(shardKeys[0], 0)
+	}
+	last := keys[len(keys)-1][1]
+	var evicted []string
+	cache.OnEvict(func(key string, _ int, _ EvictionReason) {
+		evicted = append(evicted, key)
+		// last is in the last shard, which the batch locked too.
+		cache.Get(last)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cache.MSet([]Entry[string, int]{{Key: keys[0][1]}, {Key: last}})
+		cache.MDelete([]string{keys[0][1], last})
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a batch deadlocked in an eviction callback")
+	}
+	if len(evicted) < 2 {
+		t.Fatalf("evicted %v, want the batch to evict", evicted)
+	}
+}
@@ -0,0 +1,146 @@
+package lru
+
+import (
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const benchmarkCapacity = 1 << 14
+
+func benchmarkKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = "notes:" + strconv.Itoa(i)
+	}
+	return keys
+}
+
+func BenchmarkGet(b *testing.B) {
+	keys := benchmarkKeys(benchmarkCapacity)
+	cache := New[string, string](benchmarkCapacity)
+	for _, key := range keys {
+		cache.Put(key, key)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.Get(keys[i%len(keys)])
+	}
+}
+
+// BenchmarkPut writes twice as many keys as fit, so every put in the steady
+// state evicts an entry and reuses its slot.
+func BenchmarkPut(b *testing.B) {
+	keys := benchmarkKeys(2 * benchmarkCapacity)
+	cache := New[string, string](benchmarkCapacity)
+	for _, key := range keys {
+		cache.Put(key, key)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.Put(keys[i%len(keys)], "value")
+	}
+}
+
+func BenchmarkPutExisting(b *testing.B) {
+	keys := benchmarkKeys(benchmarkCapacity)
+	cache := New[string, string](benchmarkCapacity)
+	for _, key := range keys {
+		cache.Put(key, key)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.Put(keys[i%len(keys)], "value")
+	}
+}
+
+func BenchmarkShardedGetParallel(b *testing.B) {
+	keys := benchmarkKeys(benchmarkCapacity)
+	cache := NewSharded[string, string](benchmarkCapacity, 16)
+	for _, key := range keys {
+		cache.Put(key, key)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			cache.Get(keys[i%len(keys)])
+			i++
+		}
+	})
+}
+
+func BenchmarkClockCacheGetParallel(b *testing.B) {
+	keys := benchmarkKeys(benchmarkCapacity)
+	cache := NewClockCache[string, string](benchmarkCapacity)
+	for _, key := range keys {
+		cache.Put(key, key)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			cache.Get(keys[i%len(keys)])
+			i++
+		}
+	})
+}
+
+// gcEntries is how many entries the GC benchmarks keep live, enough for the
+// cost of scanning them to dominate a collection.
+const gcEntries = 1 << 19
+
+// benchmarkGC forces a collection per iteration with the cache filled by
+// fill still reachable, and reports the stop-the-world pause per collection
+// next to the total time each one takes.
+func benchmarkGC(b *testing.B, fill func(keys []string) any) {
+	live := fill(benchmarkKeys(gcEntries))
+	runtime.GC()
+
+	var before, after debug.GCStats
+	debug.ReadGCStats(&before)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runtime.GC()
+	}
+	b.StopTimer()
+	debug.ReadGCStats(&after)
+	runtime.KeepAlive(live)
+
+	if collections := after.NumGC - before.NumGC; collections > 0 {
+		pause := after.PauseTotal - before.PauseTotal
+		b.ReportMetric(float64(pause/time.Duration(collections)), "pause-ns/gc")
+	}
+}
+
+func BenchmarkShardedGC(b *testing.B) {
+	benchmarkGC(b, func(keys []string) any {
+		cache := NewSharded[string, string](len(keys), 16)
+		for _, key := range keys {
+			cache.Put(key, key)
+		}
+		return cache
+	})
+}
+
+func BenchmarkArenaCacheGC(b *testing.B) {
+	benchmarkGC(b, func(keys []string) any {
+		cache := NewArenaCache(int64(len(keys))*64, 16)
+		for _, key := range keys {
+			cache.Put(key, []byte(key))
+		}
+		return cache
+	})
+}
@@ -0,0 +1,98 @@
+package lru
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrVersionMismatch is returned by CompareAndSet when the entry is not at
+// the version the caller expected.
+var ErrVersionMismatch = errors.New("lru: version mismatch")
+
+// CompareAndSet stores the value like PutWithOptions, but only if the entry
+// for key is at the expected version. An expected version of zero means the
+// key must not be cached; an expired entry counts as not cached. Otherwise
+// CompareAndSet returns ErrVersionMismatch along with the current version,
+// which is zero if there is no entry.
+func (cache *Cache[K, V]) CompareAndSet(key K, value V, expected uint64, options PutOptions) (uint64, error) {
+	var current uint64
+	if i, ok := cache.elements[key]; ok && !cache.entries.at(i).expired(cache.clock.Now()) {
+		current = cache.entries.at(i).version
+	}
+	if current != expected {
+		return current, fmt.Errorf("%w: expected %d, found %d", ErrVersionMismatch, expected, current)
+	}
+	return cache.PutWithOptions(key, value, options)
+}
+
+func (sharded *Sharded[K, V]) CompareAndSet(key K, value V, expected uint64, options PutOptions) (uint64, error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	return s.cache.CompareAndSet(key, value, expected, options)
+}
+
+// Condition decides whether PutIf writes, depending on whether the key is
+// cached. Expired entries count as not cached.
+type Condition int
+
+const (
+	// Always writes, like PutWithOptions.
+	Always Condition = iota
+	// IfAbsent only writes if the key is not cached.
+	IfAbsent
+	// IfPresent only writes if the key is cached.
+	IfPresent
+)
+
+// Written is the outcome of PutIf.
+type Written[V any] struct {
+	// Applied tells whether the value was stored.
+	Applied bool
+	// Previous holds the value the key had before the put, if Existed.
+	Previous V
+	Existed  bool
+	// Version is the version of the new value if the put was applied, and
+	// of the value left in place otherwise.
+	Version uint64
+}
+
+// PutIf stores the value like PutWithOptions if the condition holds, and
+// returns the previous value either way, so it also works as get-and-set.
+// A condition that doesn't hold is not an error; Applied is false instead.
+// Reading the previous value doesn't count as a use.
+func (cache *Cache[K, V]) PutIf(key K, value V, condition Condition, options PutOptions) (Written[V], error) {
+	return cache.putIf(key, value, condition, options, nil)
+}
+
+// putIf is PutIf that also leaves the key alone if check rejects the
+// previous value, and returns the error of check.
+func (cache *Cache[K, V]) putIf(key K, value V, condition Condition, options PutOptions, check func(previous V) error) (Written[V], error) {
+	var written Written[V]
+	if i, ok := cache.elements[key]; ok && !cache.entries.at(i).expired(cache.clock.Now()) {
+		pair := cache.entries.at(i)
+		written.Previous, written.Existed, written.Version = pair.value, true, pair.version
+		if check != nil {
+			if err := check(pair.value); err != nil {
+				return Written[V]{}, err
+			}
+		}
+	}
+	if condition == IfAbsent && written.Existed || condition == IfPresent && !written.Existed {
+		return written, nil
+	}
+
+	version, err := cache.PutWithOptions(key, value, options)
+	if err != nil {
+		return written, err
+	}
+	written.Applied, written.Version = true, version
+	return written, nil
+}
+
+func (sharded *Sharded[K, V]) PutIf(key K, value V, condition Condition, options PutOptions) (Written[V], error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	return s.cache.PutIf(key, value, condition, options)
+}
@@ -0,0 +1,66 @@
+package lru
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCompareAndSet(t *testing.T) {
+	clock := newFakeClock()
+	cache := New(0, WithClock[string, int](clock))
+
+	v1, err := cache.CompareAndSet("stock", 10, 0, PutOptions{TTL: time.Minute})
+	if err != nil || v1 == 0 {
+		t.Fatalf("CompareAndSet on a missing key = %d, %v", v1, err)
+	}
+	if current, err := cache.CompareAndSet("stock", 11, 0, PutOptions{}); !errors.Is(err, ErrVersionMismatch) || current != v1 {
+		t.Fatalf("CompareAndSet expecting no key = %d, %v; want %d, ErrVersionMismatch", current, err, v1)
+	}
+	v2, err := cache.CompareAndSet("stock", 9, v1, PutOptions{TTL: time.Minute})
+	if err != nil || v2 == v1 {
+		t.Fatalf("CompareAndSet at the current version = %d, %v", v2, err)
+	}
+	if _, err := cache.CompareAndSet("stock", 8, v1, PutOptions{}); !errors.Is(err, ErrVersionMismatch) {
+		t.Fatalf("CompareAndSet at a stale version = %v, want ErrVersionMismatch", err)
+	}
+	if value, _ := cache.Get("stock"); value != 9 {
+		t.Fatalf("Get = %d, want 9", value)
+	}
+
+	// An expired entry counts as missing.
+	clock.advance(time.Minute)
+	if _, err := cache.CompareAndSet("stock", 1, 0, PutOptions{}); err != nil {
+		t.Fatalf("CompareAndSet expecting no key after expiry = %v", err)
+	}
+}
+
+func TestPutIf(t *testing.T) {
+	clock := newFakeClock()
+	cache := New(0, WithClock[string, string](clock))
+
+	written, err := cache.PutIf("lock", "a", IfPresent, PutOptions{})
+	if err != nil || written.Applied || written.Existed || cache.Contains("lock") {
+		t.Fatalf("PutIf IfPresent on a missing key = %+v, %v", written, err)
+	}
+	written, _ = cache.PutIf("lock", "a", IfAbsent, PutOptions{TTL: time.Minute})
+	if !written.Applied || written.Existed || written.Version == 0 {
+		t.Fatalf("PutIf IfAbsent on a missing key = %+v", written)
+	}
+	version := written.Version
+
+	written, _ = cache.PutIf("lock", "b", IfAbsent, PutOptions{})
+	if written.Applied || !written.Existed || written.Previous != "a" || written.Version != version {
+		t.Fatalf("PutIf IfAbsent on a cached key = %+v, want a left in place", written)
+	}
+	written, _ = cache.PutIf("lock", "c", Always, PutOptions{TTL: time.Minute})
+	if !written.Applied || written.Previous != "a" || written.Version == version {
+		t.Fatalf("PutIf Always = %+v, want a swapped for c", written)
+	}
+
+	clock.advance(time.Minute)
+	written, _ = cache.PutIf("lock", "d", IfAbsent, PutOptions{})
+	if !written.Applied || written.Existed {
+		t.Fatalf("PutIf IfAbsent on an expired key = %+v", written)
+	}
+}
@@ -0,0 +1,169 @@
+package lru
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ClockCache approximates LRU with the CLOCK algorithm. Entries sit in a
+// fixed ring of slots, each with a reference bit. A read only sets the bit
+// of its slot, atomically and under a shared read lock, so concurrent reads
+// never wait for each other. To make room, the hand sweeps the ring,
+// clearing set bits and giving those entries a second chance, and evicts
+// the first entry whose bit is already clear.
+//
+// It trades the exact recency order and the extras of Cache, such as
+// policies, TTLs and byte budgets, for a read path without exclusive locks.
+type ClockCache[K comparable, V any] struct {
+	mu    sync.RWMutex
+	slots []clockSlot[K, V]
+	index map[K]int
+	free  []int
+	hand  int
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+type clockSlot[K comparable, V any] struct {
+	key        K
+	value      V
+	referenced atomic.Bool
+}
+
+func NewClockCache[K comparable, V any](capacity int) *ClockCache[K, V] {
+	capacity = max(capacity, 1)
+	cache := &ClockCache[K, V]{
+		slots: make([]clockSlot[K, V], capacity),
+		index: make(map[K]int, capacity),
+		free:  make([]int, 0, capacity),
+	}
+	for i := capacity - 1; i >= 0; i-- {
+		cache.free = append(cache.free, i)
+	}
+	return cache
+}
+
+func (cache *ClockCache[K, V]) Get(key K) (V, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	if i, ok := cache.index[key]; ok {
+		slot := &cache.slots[i]
+		// Only write the bit when it changes, so hot keys don't bounce the
+		// cache line between cores.
+		if !slot.referenced.Load() {
+			slot.referenced.Store(true)
+		}
+		cache.hits.Add(1)
+		return slot.value, true
+	}
+	cache.misses.Add(1)
+	var zero V
+	return zero, false
+}
+
+// Peek returns the value for key without setting its reference bit.
+func (cache *ClockCache[K, V]) Peek(key K) (V, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	if i, ok := cache.index[key]; ok {
+		return cache.slots[i].value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (cache *ClockCache[K, V]) Put(key K, value V) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if i, ok := cache.index[key]; ok {
+		cache.slots[i].value = value
+		cache.slots[i].referenced.Store(true)
+		return
+	}
+
+	i := cache.slotForInsert()
+	slot := &cache.slots[i]
+	slot.key = key
+	slot.value = value
+	// New entries start unreferenced, so keys that are never read again
+	// are the first to go.
+	slot.referenced.Store(false)
+	cache.index[key] = i
+}
+
+// slotForInsert returns a free slot, evicting an entry when the ring is full.
+func (cache *ClockCache[K, V]) slotForInsert() int {
+	if n := len(cache.free); n > 0 {
+		i := cache.free[n-1]
+		cache.free = cache.free[:n-1]
+		return i
+	}
+
+	for {
+		i := cache.hand
+		cache.hand = (cache.hand + 1) % len(cache.slots)
+		slot := &cache.slots[i]
+		if slot.referenced.Load() {
+			slot.referenced.Store(false)
+			continue
+		}
+
+		delete(cache.index, slot.key)
+		cache.evictions.Add(1)
+		return i
+	}
+}
+
+func (cache *ClockCache[K, V]) Remove(key K) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if i, ok := cache.index[key]; ok {
+		delete(cache.index, key)
+		cache.release(i)
+		cache.free = append(cache.free, i)
+	}
+}
+
+func (cache *ClockCache[K, V]) Clear() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.index = make(map[K]int, len(cache.slots))
+	cache.free = cache.free[:0]
+	for i := len(cache.slots) - 1; i >= 0; i-- {
+		cache.release(i)
+		cache.free = append(cache.free, i)
+	}
+	cache.hand = 0
+}
+
+// release empties slot i so it doesn't keep its key and value reachable.
+func (cache *ClockCache[K, V]) release(i int) {
+	var key K
+	var value V
+	slot := &cache.slots[i]
+	slot.key = key
+	slot.value = value
+	slot.referenced.Store(false)
+}
+
+func (cache *ClockCache[K, V]) Len() int {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	return len(cache.index)
+}
+
+func (cache *ClockCache[K, V]) Stats() Stats {
+	return Stats{
+		Hits:      cache.hits.Load(),
+		Misses:    cache.misses.Load(),
+		Evictions: cache.evictions.Load(),
+		Entries:   cache.Len(),
+	}
+}
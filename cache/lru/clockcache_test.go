@@ -0,0 +1,63 @@
+package lru
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestClockCacheGivesReadEntriesASecondChance(t *testing.T) {
+	cache := NewClockCache[string, int](3)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+
+	cache.Put("d", 4)
+	if _, ok := cache.Peek("b"); ok {
+		t.Fatal("b was not evicted")
+	}
+	for _, key := range []string{"a", "c", "d"} {
+		if _, ok := cache.Peek(key); !ok {
+			t.Fatalf("%s was evicted", key)
+		}
+	}
+	if stats := cache.Stats(); stats.Evictions != 1 || stats.Entries != 3 {
+		t.Fatalf("Stats() = %+v", stats)
+	}
+}
+
+func TestClockCacheRemoveFreesTheSlot(t *testing.T) {
+	cache := NewClockCache[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Remove("a")
+	cache.Put("c", 3)
+	if _, ok := cache.Peek("b"); !ok || cache.Stats().Evictions != 0 {
+		t.Fatal("put after Remove evicted an entry")
+	}
+}
+
+// TestClockCacheConcurrent is meant to run with -race.
+func TestClockCacheConcurrent(t *testing.T) {
+	cache := NewClockCache[string, int](64)
+	var wg sync.WaitGroup
+	for worker := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				key := strconv.Itoa((worker + i) % 128)
+				if i%4 == 0 {
+					cache.Put(key, i)
+				} else {
+					cache.Get(key)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := cache.Len(); n > 64 {
+		t.Fatalf("Len() = %d in a cache of 64", n)
+	}
+}
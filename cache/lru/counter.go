@@ -0,0 +1,65 @@
+package lru
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ErrNotInteger is returned by IncrBy when the value of the key is not a
+// decimal integer.
+var ErrNotInteger = errors.New("lru: value is not an integer")
+
+// ErrOverflow is returned by IncrBy when the new value would not fit in an
+// int64.
+var ErrOverflow = errors.New("lru: increment would overflow")
+
+// IncrBy atomically adds delta to the integer stored as a decimal string for
+// key and returns the new value along with the time left until it expires,
+// or zero if it doesn't. A missing or expired key counts as zero and is
+// created with options, so a counter gets its TTL when it is first
+// incremented, like INCR followed by EXPIRE on a new key. Incrementing an
+// existing key keeps its deadlines and other options.
+func IncrBy[K comparable, V ~string](sharded *Sharded[K, V], key K, delta int64, options PutOptions) (int64, time.Duration, error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	return incrBy(&s.cache, key, delta, options)
+}
+
+func incrBy[K comparable, V ~string](cache *Cache[K, V], key K, delta int64, options PutOptions) (int64, time.Duration, error) {
+	now := cache.clock.Now()
+	i, ok := cache.elements[key]
+	if !ok || cache.entries.at(i).expired(now) {
+		if _, err := cache.PutWithOptions(key, V(strconv.FormatInt(delta, 10)), options); err != nil {
+			return 0, 0, err
+		}
+		return delta, cache.ttlOf(key), nil
+	}
+
+	n, err := strconv.ParseInt(string(cache.entries.at(i).value), 10, 64)
+	if err != nil {
+		return 0, 0, ErrNotInteger
+	}
+	if delta > 0 && n > math.MaxInt64-delta || delta < 0 && n < math.MinInt64-delta {
+		return 0, 0, ErrOverflow
+	}
+	n += delta
+
+	if _, err := cache.replace(key, V(strconv.FormatInt(n, 10))); err != nil {
+		return 0, 0, err
+	}
+	return n, cache.ttlOf(key), nil
+}
+
+// ttlOf returns the time left until the entry for key expires, or zero if
+// it has no TTL. It reads the clock after the entry was written, so the time
+// left is never more than the TTL it was written with.
+func (cache *Cache[K, V]) ttlOf(key K) time.Duration {
+	pair := cache.entries.at(cache.elements[key])
+	if pair.expiresAt.IsZero() {
+		return 0
+	}
+	return pair.expiresAt.Sub(cache.clock.Now())
+}
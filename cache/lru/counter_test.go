@@ -0,0 +1,67 @@
+package lru
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestIncrBySetsTTLOnCreation(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewSharded(0, 2, WithClock[string, string](clock))
+
+	n, ttl, err := IncrBy(cache, "hits", 1, PutOptions{TTL: time.Minute})
+	if n != 1 || ttl != time.Minute || err != nil {
+		t.Fatalf("IncrBy = %d, %v, %v; want 1, 1m, nil", n, ttl, err)
+	}
+	clock.advance(20 * time.Second)
+	n, ttl, _ = IncrBy(cache, "hits", 5, PutOptions{TTL: time.Hour})
+	if n != 6 || ttl != 40*time.Second {
+		t.Fatalf("IncrBy = %d, %v; want 6, 40s left of the first TTL", n, ttl)
+	}
+
+	clock.advance(40 * time.Second)
+	n, ttl, _ = IncrBy(cache, "hits", -1, PutOptions{TTL: time.Minute})
+	if n != -1 || ttl != time.Minute {
+		t.Fatalf("IncrBy after expiry = %d, %v; want -1, 1m", n, ttl)
+	}
+}
+
+func TestIncrByNeverReportsMoreThanTheTTL(t *testing.T) {
+	clock := newFakeClock()
+	clock.tick = time.Millisecond
+	cache := NewSharded(0, 1, WithClock[string, string](clock))
+
+	for range 3 {
+		if _, ttl, _ := IncrBy(cache, "hits", 1, PutOptions{TTL: time.Second}); ttl > time.Second {
+			t.Fatalf("IncrBy reported %v left of a 1s TTL", ttl)
+		}
+	}
+}
+
+func TestIncrByWithoutTTL(t *testing.T) {
+	cache := NewSharded[string, string](0, 1)
+	cache.Put("n", "41")
+	if n, ttl, err := IncrBy(cache, "n", 1, PutOptions{TTL: time.Minute}); n != 42 || ttl != 0 || err != nil {
+		t.Fatalf("IncrBy = %d, %v, %v; want 42, 0, nil", n, ttl, err)
+	}
+	if value, _ := cache.Get("n"); value != "42" {
+		t.Fatalf("Get = %q, want 42", value)
+	}
+}
+
+func TestIncrByErrors(t *testing.T) {
+	cache := NewSharded[string, string](0, 1)
+	cache.Put("text", "hello")
+	if _, _, err := IncrBy(cache, "text", 1, PutOptions{}); !errors.Is(err, ErrNotInteger) {
+		t.Fatalf("IncrBy on text = %v, want ErrNotInteger", err)
+	}
+	cache.Put("max", "9223372036854775807")
+	if _, _, err := IncrBy(cache, "max", 1, PutOptions{}); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("IncrBy past MaxInt64 = %v, want ErrOverflow", err)
+	}
+	if n, _, err := IncrBy(cache, "max", math.MinInt64, PutOptions{}); n != -1 || err != nil {
+		t.Fatalf("IncrBy by MinInt64 = %d, %v; want -1, nil", n, err)
+	}
+}
@@ -0,0 +1,76 @@
+package lru
+
+// EvictionReason tells an eviction callback why an entry left the cache.
+type EvictionReason int
+
+const (
+	// Capacity means the policy picked the entry to make room.
+	Capacity EvictionReason = iota
+	// Expired means the TTL of the entry ran out.
+	Expired
+	// Removed means the entry was removed explicitly.
+	Removed
+	// Cleared means the whole cache was cleared.
+	Cleared
+	// Replaced means a put overwrote the value of the entry.
+	Replaced
+)
+
+func (reason EvictionReason) String() string {
+	switch reason {
+	case Capacity:
+		return "capacity"
+	case Expired:
+		return "expired"
+	case Removed:
+		return "removed"
+	case Cleared:
+		return "cleared"
+	case Replaced:
+		return "replaced"
+	}
+	return "unknown"
+}
+
+// EvictionCallback receives an entry that left the cache and the reason.
+type EvictionCallback[K comparable, V any] func(key K, value V, reason EvictionReason)
+
+type eviction[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictionReason
+}
+
+// OnEvict registers a callback for entries leaving the cache. Callbacks run
+// once the operation that dropped the entry has finished, and for Sharded
+// after the shard lock is released, so they may do I/O or use the cache.
+func (cache *Cache[K, V]) OnEvict(callback EvictionCallback[K, V]) {
+	cache.callbacks = append(cache.callbacks, callback)
+}
+
+// evicted queues the callbacks for an entry that was just dropped. Nothing
+// is recorded while no callback is registered.
+func (cache *Cache[K, V]) evicted(pair KeyPair[K, V], reason EvictionReason) {
+	if len(cache.callbacks) > 0 {
+		cache.pending = append(cache.pending, eviction[K, V]{key: pair.key, value: pair.value, reason: reason})
+	}
+}
+
+// flush runs the queued callbacks, unless a Sharded owns the cache and runs
+// them itself after unlocking.
+func (cache *Cache[K, V]) flush() {
+	if cache.deferred || len(cache.pending) == 0 {
+		return
+	}
+	evictions := cache.pending
+	cache.pending = nil
+	notify(cache.callbacks, evictions)
+}
+
+func notify[K comparable, V any](callbacks []EvictionCallback[K, V], evictions []eviction[K, V]) {
+	for _, eviction := range evictions {
+		for _, callback := range callbacks {
+			callback(eviction.key, eviction.value, eviction.reason)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package lru
+
+import "container/heap"
+
+// GDSF is the Greedy-Dual-Size-Frequency policy. Every key has a priority
+//
+//	L + frequency * cost / size
+//
+// and the key with the lowest priority is evicted. Small entries that are
+// read often and were expensive to compute stay; large, cheap and cold ones
+// go first. L is the priority of the last evicted key, so keys that have not
+// been touched for a while age relative to newly inserted ones.
+//
+// Sizes and costs come from Weigh. Until a key is weighed it counts with a
+// size and cost of one.
+type GDSF[K comparable] struct {
+	entries   gdsfHeap[K]
+	index     map[K]*gdsfEntry[K]
+	inflation float64
+	// victim is the last key handed out by Victim. Only its removal is an
+	// eviction that raises the inflation L.
+	victim    K
+	hasVictim bool
+}
+
+type gdsfEntry[K comparable] struct {
+	key       K
+	priority  float64
+	frequency uint64
+	size      int64
+	cost      float64
+	position  int
+}
+
+func NewGDSF[K comparable]() Policy[K] {
+	return &GDSF[K]{index: make(map[K]*gdsfEntry[K])}
+}
+
+func (policy *GDSF[K]) Insert(key K) {
+	entry := &gdsfEntry[K]{key: key, frequency: 1, size: 1, cost: 1}
+	entry.priority = policy.priority(entry)
+	policy.index[key] = entry
+	heap.Push(&policy.entries, entry)
+}
+
+func (policy *GDSF[K]) Access(key K) {
+	if entry, ok := policy.index[key]; ok {
+		entry.frequency++
+		policy.update(entry)
+	}
+}
+
+func (policy *GDSF[K]) Weigh(key K, size int64, cost float64) {
+	if entry, ok := policy.index[key]; ok {
+		entry.size = max(size, 1)
+		entry.cost = cost
+		policy.update(entry)
+	}
+}
+
+func (policy *GDSF[K]) Victim() (K, bool) {
+	if len(policy.entries) == 0 {
+		var zero K
+		return zero, false
+	}
+	policy.victim, policy.hasVictim = policy.entries[0].key, true
+	return policy.victim, true
+}
+
+func (policy *GDSF[K]) Remove(key K) {
+	evicted := policy.hasVictim && key == policy.victim
+	policy.hasVictim = false
+
+	entry, ok := policy.index[key]
+	if !ok {
+		return
+	}
+	if evicted {
+		policy.inflation = entry.priority
+	}
+	delete(policy.index, key)
+	heap.Remove(&policy.entries, entry.position)
+}
+
+func (policy *GDSF[K]) Clear() {
+	policy.entries = nil
+	policy.index = make(map[K]*gdsfEntry[K])
+	policy.inflation = 0
+	policy.hasVictim = false
+}
+
+func (policy *GDSF[K]) priority(entry *gdsfEntry[K]) float64 {
+	return policy.inflation + float64(entry.frequency)*entry.cost/float64(entry.size)
+}
+
+func (policy *GDSF[K]) update(entry *gdsfEntry[K]) {
+	entry.priority = policy.priority(entry)
+	heap.Fix(&policy.entries, entry.position)
+}
+
+// gdsfHeap is a min-heap of entries ordered by priority.
+type gdsfHeap[K comparable] []*gdsfEntry[K]
+
+func (h gdsfHeap[K]) Len() int {
+	return len(h)
+}
+
+func (h gdsfHeap[K]) Less(i, j int) bool {
+	return h[i].priority < h[j].priority
+}
+
+func (h gdsfHeap[K]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].position = i
+	h[j].position = j
+}
+
+func (h *gdsfHeap[K]) Push(x any) {
+	entry := x.(*gdsfEntry[K])
+	entry.position = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *gdsfHeap[K]) Pop() any {
+	old := *h
+	entry := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return entry
+}
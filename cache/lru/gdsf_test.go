@@ -0,0 +1,72 @@
+package lru
+
+import "testing"
+
+func TestGDSFKeepsCostlyEntries(t *testing.T) {
+	cache := New(2, WithPolicy[string, string](NewGDSF[string]))
+	cache.PutWithOptions("costly", "value", PutOptions{Cost: 100})
+	cache.PutWithOptions("cheap", "value", PutOptions{Cost: 1})
+	// Recency would evict costly first.
+	cache.Get("cheap")
+
+	cache.Put("new", "value")
+	if cache.Contains("cheap") || !cache.Contains("costly") {
+		t.Fatalf("Keys() = %v, want cheap evicted before costly", cache.Keys())
+	}
+}
+
+func TestGDSFPrefersSmallAndFrequent(t *testing.T) {
+	policy := NewGDSF[string]().(*GDSF[string])
+	policy.Insert("large")
+	policy.Weigh("large", 1000, 1)
+	policy.Insert("small")
+	policy.Weigh("small", 10, 1)
+	if victim, _ := policy.Victim(); victim != "large" {
+		t.Fatalf("Victim() = %q, want the large entry", victim)
+	}
+
+	policy.Insert("other")
+	policy.Weigh("other", 10, 1)
+	policy.Access("small")
+	policy.Remove("large")
+	if victim, _ := policy.Victim(); victim != "other" {
+		t.Fatalf("Victim() = %q, want the entry read less often", victim)
+	}
+}
+
+func TestGDSFInflatesOnlyOnEviction(t *testing.T) {
+	policy := NewGDSF[string]().(*GDSF[string])
+	policy.Insert("a")
+	policy.Insert("b")
+	policy.Weigh("b", 1, 5)
+	policy.Insert("c")
+	policy.Weigh("c", 1, 9)
+
+	policy.Remove("a")
+	if policy.inflation != 0 {
+		t.Fatalf("inflation = %v after a removal, want 0", policy.inflation)
+	}
+	// A removal after Victim that is not the victim doesn't count either.
+	if victim, _ := policy.Victim(); victim != "b" {
+		t.Fatalf("Victim() = %q, want b", victim)
+	}
+	policy.Remove("c")
+	if policy.inflation != 0 {
+		t.Fatalf("inflation = %v after removing a key that was not the victim", policy.inflation)
+	}
+
+	policy.Insert("c")
+	policy.Weigh("c", 1, 9)
+	policy.Victim()
+	policy.Remove("b")
+	if policy.inflation != 5 {
+		t.Fatalf("inflation = %v after evicting b, want its priority 5", policy.inflation)
+	}
+	// New keys start from the inflated priority, so they outrank old ones
+	// that have not been touched.
+	policy.Insert("d")
+	policy.Weigh("d", 1, 5)
+	if victim, _ := policy.Victim(); victim != "c" {
+		t.Fatalf("Victim() = %q, want c, whose priority was set before the inflation", victim)
+	}
+}
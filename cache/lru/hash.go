@@ -0,0 +1,235 @@
+package lru
+
+import (
+	"encoding/binary"
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// ErrWrongType is returned by the hash functions when the key holds a plain
+// value rather than a hash, and by PutIfNotHash the other way around.
+var ErrWrongType = errors.New("lru: key holds the wrong kind of value")
+
+// ErrTooManyFields is returned when a write would give a hash more fields
+// than the limit set WithMaxHashFields.
+var ErrTooManyFields = errors.New("lru: hash has too many fields")
+
+// WithMaxHashFields bounds the number of fields of a hash. Zero is not
+// enforced, like the capacity.
+func WithMaxHashFields[K comparable, V any](fields int) Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.maxFields = fields
+	}
+}
+
+// IsHash reports whether value holds a hash rather than a plain value.
+func IsHash[V ~string](value V) bool {
+	return len(value) > 0 && value[0] == 0
+}
+
+// encodeHash turns a hash into the value stored under its key. A hash maps
+// fields to values under a single key, so a record can be read and updated
+// a field at a time. It is stored as one value and counts as one entry for
+// eviction and expiry: the options given when it is created apply to the
+// whole hash. Every write re-encodes the hash, which the field limit keeps
+// cheap.
+//
+// The encoding is a zero byte, which no plain value written by the server
+// starts with, and then the length and bytes of each field and value in the
+// order of the fields.
+func encodeHash(fields map[string]string) string {
+	buf := []byte{0}
+	for _, field := range slices.Sorted(maps.Keys(fields)) {
+		buf = binary.AppendUvarint(buf, uint64(len(field)))
+		buf = append(buf, field...)
+		buf = binary.AppendUvarint(buf, uint64(len(fields[field])))
+		buf = append(buf, fields[field]...)
+	}
+	return string(buf)
+}
+
+func decodeHash(s string) (map[string]string, error) {
+	if !IsHash(s) {
+		return nil, ErrWrongType
+	}
+	fields := make(map[string]string)
+	for s = s[1:]; s != ""; {
+		field, rest, ok := cutString(s)
+		if !ok {
+			return nil, ErrWrongType
+		}
+		value, rest, ok := cutString(rest)
+		if !ok {
+			return nil, ErrWrongType
+		}
+		fields[field], s = value, rest
+	}
+	return fields, nil
+}
+
+// cutString reads a string prefixed with its length from the front of s.
+func cutString(s string) (string, string, bool) {
+	n, size := binary.Uvarint([]byte(s[:min(len(s), binary.MaxVarintLen64)]))
+	if size <= 0 || n > uint64(len(s)-size) {
+		return "", "", false
+	}
+	return s[size : size+int(n)], s[size+int(n):], true
+}
+
+// hashOf decodes the hash stored for key without counting it as a use. A
+// missing or expired key holds an empty hash.
+func hashOf[K comparable, V ~string](cache *Cache[K, V], key K) (map[string]string, bool, error) {
+	i, ok := cache.elements[key]
+	if !ok || cache.entries.at(i).expired(cache.clock.Now()) {
+		return make(map[string]string), false, nil
+	}
+	fields, err := decodeHash(string(cache.entries.at(i).value))
+	return fields, true, err
+}
+
+// storeHash writes the hash back, creating the key with options if it did
+// not exist, or removes the key once the hash has no fields left.
+func storeHash[K comparable, V ~string](cache *Cache[K, V], key K, fields map[string]string, existed bool, options PutOptions) error {
+	if cache.maxFields > 0 && len(fields) > cache.maxFields {
+		return ErrTooManyFields
+	}
+	var err error
+	switch {
+	case len(fields) == 0:
+		cache.Remove(key)
+	case existed:
+		_, err = cache.replace(key, V(encodeHash(fields)))
+	default:
+		_, err = cache.PutWithOptions(key, V(encodeHash(fields)), options)
+	}
+	return err
+}
+
+// PutIfNotHash is PutIf for callers that use the previous value as a plain
+// value. If the key holds a hash, it is left in place and PutIfNotHash
+// returns ErrWrongType.
+func PutIfNotHash[K comparable, V ~string](sharded *Sharded[K, V], key K, value V, condition Condition, options PutOptions) (Written[V], error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	return s.cache.putIf(key, value, condition, options, func(previous V) error {
+		if IsHash(previous) {
+			return ErrWrongType
+		}
+		return nil
+	})
+}
+
+// HSet sets the given fields of the hash under key and returns how many of
+// them are new. A missing key is created with options.
+func HSet[K comparable, V ~string](sharded *Sharded[K, V], key K, fields map[string]string, options PutOptions) (int, error) {
+	if len(fields) == 0 {
+		return 0, nil
+	}
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+
+	hash, existed, err := hashOf(&s.cache, key)
+	if err != nil {
+		return 0, err
+	}
+	added := 0
+	for field, value := range fields {
+		if _, ok := hash[field]; !ok {
+			added++
+		}
+		hash[field] = value
+	}
+	return added, storeHash(&s.cache, key, hash, existed, options)
+}
+
+// HGet returns a field of the hash under key. Reading any field counts as a
+// use of the key.
+func HGet[K comparable, V ~string](sharded *Sharded[K, V], key K, field string) (string, bool, error) {
+	values, found, err := HMGet(sharded, key, []string{field})
+	if err != nil {
+		return "", false, err
+	}
+	return values[0], found[0], nil
+}
+
+// HMGet returns the given fields of the hash under key, in order.
+func HMGet[K comparable, V ~string](sharded *Sharded[K, V], key K, fields []string) (values []string, found []bool, err error) {
+	hash, err := HGetAll(sharded, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, found = make([]string, len(fields)), make([]bool, len(fields))
+	for i, field := range fields {
+		values[i], found[i] = hash[field]
+	}
+	return values, found, nil
+}
+
+// HGetAll returns all fields of the hash under key, or nil if it is not
+// cached.
+func HGetAll[K comparable, V ~string](sharded *Sharded[K, V], key K) (map[string]string, error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+
+	pair, ok := s.cache.get(key)
+	if !ok {
+		return nil, nil
+	}
+	return decodeHash(string(pair.value))
+}
+
+// HDel removes the given fields of the hash under key and returns how many
+// were there. Removing the last field removes the key.
+func HDel[K comparable, V ~string](sharded *Sharded[K, V], key K, fields []string) (int, error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+
+	hash, existed, err := hashOf(&s.cache, key)
+	if err != nil || !existed {
+		return 0, err
+	}
+	removed := 0
+	for _, field := range fields {
+		if _, ok := hash[field]; ok {
+			delete(hash, field)
+			removed++
+		}
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, storeHash(&s.cache, key, hash, existed, PutOptions{})
+}
+
+// HIncrBy adds delta to the integer in a field of the hash under key, like
+// IncrBy, and returns the new value. A missing field counts as zero and a
+// missing key is created with options.
+func HIncrBy[K comparable, V ~string](sharded *Sharded[K, V], key K, field string, delta int64, options PutOptions) (int64, error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+
+	hash, existed, err := hashOf(&s.cache, key)
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	if value, ok := hash[field]; ok {
+		if n, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return 0, ErrNotInteger
+		}
+	}
+	if delta > 0 && n > math.MaxInt64-delta || delta < 0 && n < math.MinInt64-delta {
+		return 0, ErrOverflow
+	}
+	n += delta
+	hash[field] = strconv.FormatInt(n, 10)
+	return n, storeHash(&s.cache, key, hash, existed, options)
+}
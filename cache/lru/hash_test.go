@@ -0,0 +1,88 @@
+package lru
+
+import (
+	"errors"
+	"maps"
+	"testing"
+)
+
+func TestHashEncoding(t *testing.T) {
+	for _, fields := range []map[string]string{
+		{},
+		{"name": "Ada"},
+		{"": "", "empty": "", "\x00": "\x00\x01", "long": string(make([]byte, 300))},
+	} {
+		encoded := encodeHash(fields)
+		if !IsHash(encoded) {
+			t.Fatalf("encodeHash(%q) = %q is not a hash", fields, encoded)
+		}
+		decoded, err := decodeHash(encoded)
+		if err != nil || !maps.Equal(decoded, fields) {
+			t.Fatalf("decodeHash(encodeHash(%q)) = %q, %v", fields, decoded, err)
+		}
+	}
+	if encodeHash(map[string]string{"a": "1", "b": "2"}) != encodeHash(map[string]string{"b": "2", "a": "1"}) {
+		t.Fatal("the encoding depends on map order")
+	}
+
+	for _, s := range []string{"", "plain", "\x00\x05abc", "\x00\x01a", "\x00\xff"} {
+		if _, err := decodeHash(s); !errors.Is(err, ErrWrongType) {
+			t.Fatalf("decodeHash(%q) = %v, want ErrWrongType", s, err)
+		}
+	}
+}
+
+func TestHashFields(t *testing.T) {
+	cache := NewSharded[string, string](0, 2, WithMaxHashFields[string, string](3))
+
+	if added, err := HSet(cache, "user:1", map[string]string{"name": "Ada", "lang": "en"}, PutOptions{}); added != 2 || err != nil {
+		t.Fatalf("HSet = %d, %v; want 2 new fields", added, err)
+	}
+	if added, _ := HSet(cache, "user:1", map[string]string{"lang": "fr"}, PutOptions{}); added != 0 {
+		t.Fatalf("HSet of an existing field added %d", added)
+	}
+	if value, ok, err := HGet(cache, "user:1", "lang"); value != "fr" || !ok || err != nil {
+		t.Fatalf("HGet = %q, %v, %v", value, ok, err)
+	}
+	values, found, _ := HMGet(cache, "user:1", []string{"name", "age"})
+	if values[0] != "Ada" || !found[0] || found[1] {
+		t.Fatalf("HMGet = %q, %v", values, found)
+	}
+
+	if n, err := HIncrBy(cache, "user:1", "visits", 2, PutOptions{}); n != 2 || err != nil {
+		t.Fatalf("HIncrBy = %d, %v", n, err)
+	}
+	if _, err := HIncrBy(cache, "user:1", "name", 1, PutOptions{}); !errors.Is(err, ErrNotInteger) {
+		t.Fatalf("HIncrBy of text = %v, want ErrNotInteger", err)
+	}
+	if _, err := HSet(cache, "user:1", map[string]string{"age": "36"}, PutOptions{}); !errors.Is(err, ErrTooManyFields) {
+		t.Fatalf("HSet of a fourth field = %v, want ErrTooManyFields", err)
+	}
+
+	if removed, _ := HDel(cache, "user:1", []string{"name", "age"}); removed != 1 {
+		t.Fatalf("HDel removed %d fields, want 1", removed)
+	}
+	HDel(cache, "user:1", []string{"lang", "visits"})
+	if cache.Contains("user:1") {
+		t.Fatal("the key is left after its last field was removed")
+	}
+}
+
+func TestHashWrongType(t *testing.T) {
+	cache := NewSharded[string, string](0, 1)
+	cache.Put("plain", "value")
+	if _, err := HSet(cache, "plain", map[string]string{"a": "1"}, PutOptions{}); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("HSet on a plain value = %v, want ErrWrongType", err)
+	}
+
+	HSet(cache, "hash", map[string]string{"a": "1"}, PutOptions{})
+	if _, err := PutIfNotHash(cache, "hash", "value", Always, PutOptions{}); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("PutIfNotHash on a hash = %v, want ErrWrongType", err)
+	}
+	if fields, _ := HGetAll(cache, "hash"); !maps.Equal(fields, map[string]string{"a": "1"}) {
+		t.Fatalf("HGetAll = %q after a rejected put", fields)
+	}
+	if written, err := PutIfNotHash(cache, "plain", "new", Always, PutOptions{}); err != nil || written.Previous != "value" {
+		t.Fatalf("PutIfNotHash on a plain value = %+v, %v", written, err)
+	}
+}
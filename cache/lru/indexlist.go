@@ -0,0 +1,106 @@
+package lru
+
+// indexList is an intrusive doubly linked list whose nodes live in a single
+// slice and link to each other by index instead of by pointer. Slot 0 is the
+// sentinel: its next is the front of the list and its prev is the back, so
+// index 0 also stands for "no node". Removed slots are chained through next
+// into a free list and reused, so a list that stays around the same length
+// allocates nothing once it has grown, and the garbage collector sees one
+// slice instead of a pointer per node.
+type indexList[T any] struct {
+	nodes  []indexNode[T]
+	free   int32
+	length int
+}
+
+type indexNode[T any] struct {
+	value      T
+	prev, next int32
+}
+
+func newIndexList[T any](capacity int) indexList[T] {
+	return indexList[T]{nodes: make([]indexNode[T], 1, capacity+1)}
+}
+
+func (list *indexList[T]) len() int {
+	return list.length
+}
+
+func (list *indexList[T]) front() int32 {
+	return list.nodes[0].next
+}
+
+func (list *indexList[T]) back() int32 {
+	return list.nodes[0].prev
+}
+
+func (list *indexList[T]) next(i int32) int32 {
+	return list.nodes[i].next
+}
+
+func (list *indexList[T]) prev(i int32) int32 {
+	return list.nodes[i].prev
+}
+
+// at returns the value stored in slot i. The pointer is only valid until
+// the next pushFront, which may grow the slice.
+func (list *indexList[T]) at(i int32) *T {
+	return &list.nodes[i].value
+}
+
+// pushFront stores value in a free slot, or a new one, at the front of the
+// list and returns its index.
+func (list *indexList[T]) pushFront(value T) int32 {
+	i := list.free
+	if i != 0 {
+		list.free = list.nodes[i].next
+		list.nodes[i].value = value
+	} else {
+		i = int32(len(list.nodes))
+		list.nodes = append(list.nodes, indexNode[T]{value: value})
+	}
+	list.link(i)
+	list.length++
+	return i
+}
+
+func (list *indexList[T]) moveToFront(i int32) {
+	if list.nodes[0].next == i {
+		return
+	}
+	list.unlink(i)
+	list.link(i)
+}
+
+// remove unlinks slot i and puts it on the free list. The value is zeroed
+// so the list doesn't keep it reachable.
+func (list *indexList[T]) remove(i int32) {
+	list.unlink(i)
+	var zero T
+	list.nodes[i].value = zero
+	list.nodes[i].prev = 0
+	list.nodes[i].next = list.free
+	list.free = i
+	list.length--
+}
+
+func (list *indexList[T]) clear() {
+	clear(list.nodes)
+	list.nodes = list.nodes[:1]
+	list.free = 0
+	list.length = 0
+}
+
+func (list *indexList[T]) link(i int32) {
+	front := list.nodes[0].next
+	list.nodes[i].prev = 0
+	list.nodes[i].next = front
+	list.nodes[front].prev = i
+	list.nodes[0].next = i
+}
+
+func (list *indexList[T]) unlink(i int32) {
+	prev, next := list.nodes[i].prev, list.nodes[i].next
+	list.nodes[prev].next = next
+	list.nodes[next].prev = prev
+}
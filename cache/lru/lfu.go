@@ -0,0 +1,87 @@
+package lru
+
+import "container/list"
+
+// LFU evicts the least frequently used key, breaking ties by recency. Keys
+// live in buckets of equal frequency kept in ascending order, so every
+// operation is O(1).
+type LFU[K comparable] struct {
+	buckets *list.List
+	entries map[K]*lfuEntry[K]
+}
+
+type lfuBucket struct {
+	frequency uint64
+	entries   *list.List
+}
+
+type lfuEntry[K comparable] struct {
+	key    K
+	bucket *list.Element
+	node   *list.Element
+}
+
+func NewLFU[K comparable]() Policy[K] {
+	return &LFU[K]{
+		buckets: new(list.List),
+		entries: make(map[K]*lfuEntry[K]),
+	}
+}
+
+func (policy *LFU[K]) Insert(key K) {
+	bucket := policy.buckets.Front()
+	if bucket == nil || bucket.Value.(*lfuBucket).frequency != 1 {
+		bucket = policy.buckets.PushFront(&lfuBucket{frequency: 1, entries: new(list.List)})
+	}
+
+	entry := &lfuEntry[K]{key: key, bucket: bucket}
+	entry.node = bucket.Value.(*lfuBucket).entries.PushFront(entry)
+	policy.entries[key] = entry
+}
+
+func (policy *LFU[K]) Access(key K) {
+	entry, ok := policy.entries[key]
+	if !ok {
+		return
+	}
+
+	current := entry.bucket
+	frequency := current.Value.(*lfuBucket).frequency + 1
+	next := current.Next()
+	if next == nil || next.Value.(*lfuBucket).frequency != frequency {
+		next = policy.buckets.InsertAfter(&lfuBucket{frequency: frequency, entries: new(list.List)}, current)
+	}
+
+	policy.unlink(entry)
+	entry.bucket = next
+	entry.node = next.Value.(*lfuBucket).entries.PushFront(entry)
+}
+
+func (policy *LFU[K]) Victim() (K, bool) {
+	if bucket := policy.buckets.Front(); bucket != nil {
+		return bucket.Value.(*lfuBucket).entries.Back().Value.(*lfuEntry[K]).key, true
+	}
+	var zero K
+	return zero, false
+}
+
+func (policy *LFU[K]) Remove(key K) {
+	if entry, ok := policy.entries[key]; ok {
+		delete(policy.entries, key)
+		policy.unlink(entry)
+	}
+}
+
+func (policy *LFU[K]) Clear() {
+	policy.buckets = new(list.List)
+	policy.entries = make(map[K]*lfuEntry[K])
+}
+
+// unlink takes the entry out of its bucket and drops the bucket once empty.
+func (policy *LFU[K]) unlink(entry *lfuEntry[K]) {
+	bucket := entry.bucket.Value.(*lfuBucket)
+	bucket.entries.Remove(entry.node)
+	if bucket.entries.Len() == 0 {
+		policy.buckets.Remove(entry.bucket)
+	}
+}
@@ -0,0 +1,53 @@
+package lru
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLFUEvictsLeastFrequentlyUsed(t *testing.T) {
+	policy := NewLFU[string]()
+	for _, key := range []string{"a", "b", "c", "d"} {
+		policy.Insert(key)
+	}
+	policy.Access("a")
+	policy.Access("a")
+	policy.Access("b")
+	policy.Access("d")
+
+	// c was read least; b and d were read as often, and b longer ago.
+	if victims := evictAll(policy); !slices.Equal(victims, []string{"c", "b", "d", "a"}) {
+		t.Fatalf("victims = %v, want [c b d a]", victims)
+	}
+}
+
+func TestLFUBuckets(t *testing.T) {
+	policy := NewLFU[string]().(*LFU[string])
+	policy.Insert("a")
+	policy.Insert("b")
+	policy.Access("a")
+	policy.Access("a")
+	if n := policy.buckets.Len(); n != 2 {
+		t.Fatalf("%d buckets for frequencies 1 and 3, want 2", n)
+	}
+
+	// A new key starts at frequency one, in the first bucket.
+	policy.Remove("b")
+	if n := policy.buckets.Len(); n != 1 {
+		t.Fatalf("%d buckets after the last key of frequency 1 left, want 1", n)
+	}
+	policy.Insert("c")
+	if n := policy.buckets.Len(); n != 2 {
+		t.Fatalf("%d buckets, want 2", n)
+	}
+	if victim, _ := policy.Victim(); victim != "c" {
+		t.Fatalf("Victim() = %q, want the new key", victim)
+	}
+	frequencies := []uint64{}
+	for bucket := policy.buckets.Front(); bucket != nil; bucket = bucket.Next() {
+		frequencies = append(frequencies, bucket.Value.(*lfuBucket).frequency)
+	}
+	if !slices.Equal(frequencies, []uint64{1, 3}) {
+		t.Fatalf("bucket frequencies = %v, want [1 3]", frequencies)
+	}
+}
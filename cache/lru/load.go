@@ -0,0 +1,292 @@
+package lru
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrLeaseHeld is returned by Lease when another caller holds the lease for
+// the key and does not fill it before the context ends.
+var ErrLeaseHeld = errors.New("lru: another caller is loading the key")
+
+// ErrLeaseRevoked is returned by Fill when the lease has expired, or the key
+// was written or removed while the lease was held, so the loaded value may
+// be stale.
+var ErrLeaseRevoked = errors.New("lru: lease expired or was revoked")
+
+// WithNegativeTTL makes GetOrLoad remember a failed load for ttl. Until
+// then, GetOrLoad returns the same error for the key without calling the
+// loader again, so a key that cannot be loaded doesn't hammer the backend.
+func WithNegativeTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.negativeTTL = ttl
+	}
+}
+
+// flight is a load of a missing key in progress, either by the loader of a
+// GetOrLoad call or by a caller holding a lease. Everyone else who misses
+// the key waits for it to land instead of loading the key again.
+type flight[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+	// token is the lease of a caller loading the key itself; it is zero for
+	// GetOrLoad. A lease expires at expiresAt.
+	token     uint64
+	expiresAt time.Time
+	// started is when the load began, to record how long it took.
+	started time.Time
+}
+
+type failure struct {
+	err       error
+	expiresAt time.Time
+}
+
+// land ends the flight and wakes up everyone waiting for it.
+func (f *flight[V]) land(value V, err error) {
+	f.value, f.err = value, err
+	close(f.done)
+}
+
+// wait blocks until the flight lands, for at most timeout if it is positive,
+// or until ctx ends. A lease that runs out of time counts as revoked.
+func (f *flight[V]) wait(ctx context.Context, timeout time.Duration) (V, error) {
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	var zero V
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-expired:
+		return zero, ErrLeaseRevoked
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// inFlight returns the load in progress for key, and for a lease how long
+// it has left. Expired leases are ignored.
+func (cache *Cache[K, V]) inFlight(key K) (*flight[V], time.Duration, bool) {
+	f, ok := cache.flights[key]
+	if !ok || f.token == 0 {
+		return f, 0, ok
+	}
+	left := f.expiresAt.Sub(cache.clock.Now())
+	return f, left, left > 0
+}
+
+// takeOff records a new load of key, replacing an expired lease.
+func (cache *Cache[K, V]) takeOff(key K, token uint64, timeout time.Duration) *flight[V] {
+	cache.revoke(key)
+	f := &flight[V]{done: make(chan struct{}), token: token, started: cache.clock.Now()}
+	if token != 0 {
+		f.expiresAt = f.started.Add(timeout)
+	}
+	cache.flights[key] = f
+	return f
+}
+
+// revoke drops the load in progress for key, because the key was written or
+// removed and the loaded value may be stale. A lease lands with
+// ErrLeaseRevoked so its waiters try again. The loader of GetOrLoad still
+// lands its own flight, but its result is not stored.
+func (cache *Cache[K, V]) revoke(key K) {
+	if f, ok := cache.flights[key]; ok {
+		delete(cache.flights, key)
+		if f.token != 0 {
+			var zero V
+			f.land(zero, ErrLeaseRevoked)
+		}
+	}
+	delete(cache.failures, key)
+}
+
+// failed returns the error of a recent failed load of key, if it is still
+// remembered.
+func (cache *Cache[K, V]) failed(key K) error {
+	failure, ok := cache.failures[key]
+	if !ok {
+		return nil
+	}
+	if cache.clock.Now().Before(failure.expiresAt) {
+		return failure.err
+	}
+	delete(cache.failures, key)
+	return nil
+}
+
+// GetOrLoad returns the value for key, calling load on a miss and storing
+// what it returns with the given options. Concurrent misses on the same key
+// share a single call to load, or wait for the holder of a lease on the key
+// to fill it. A panic in load is returned as an error to every waiter.
+//
+// Hits are refreshed too. A stale entry is returned right away and load
+// runs in the background. An entry due for early refresh is reloaded by the
+// caller that was picked, while everyone else gets the cached value. The
+// time load takes is recorded as the recompute time of the entry, unless
+// options has one. If a refresh fails, the cached value is kept and served
+// until it expires; with WithNegativeTTL the refresh is not retried before
+// the negative TTL has passed.
+//
+// A loaded value that is too large to be cached is still returned. Errors
+// are not cached unless the cache was built WithNegativeTTL. ctx only bounds
+// the wait for someone else's load.
+func (sharded *Sharded[K, V]) GetOrLoad(ctx context.Context, key K, load func(key K) (V, error), options PutOptions) (V, error) {
+	s := sharded.shardFor(key)
+	var cached V
+	var hit bool
+	for {
+		s.Lock()
+		pair, ok := s.cache.get(key)
+		if ok {
+			cached, hit = pair.value, true
+			if !s.cache.refreshDue(key) || s.cache.failed(key) != nil {
+				s.unlock()
+				return cached, nil
+			}
+			if pair.stale(s.cache.clock.Now()) {
+				f := s.cache.takeOff(key, 0, 0)
+				s.unlock()
+				go sharded.load(s, key, f, load, options)
+				return cached, nil
+			}
+			break
+		}
+		if err := s.cache.failed(key); err != nil {
+			s.unlock()
+			var zero V
+			return zero, err
+		}
+		f, left, ok := s.cache.inFlight(key)
+		if !ok {
+			break
+		}
+		s.unlock()
+
+		value, err := f.wait(ctx, left)
+		if !errors.Is(err, ErrLeaseRevoked) {
+			return value, err
+		}
+	}
+	f := s.cache.takeOff(key, 0, 0)
+	s.unlock()
+
+	value, err := sharded.load(s, key, f, load, options)
+	if err != nil && hit {
+		return cached, nil
+	}
+	return value, err
+}
+
+// load runs the flight f started by GetOrLoad and stores its result, unless
+// the key was written or removed meanwhile.
+func (sharded *Sharded[K, V]) load(s *shard[K, V], key K, f *flight[V], load func(key K) (V, error), options PutOptions) (V, error) {
+	value, err := call(load, key)
+
+	s.Lock()
+	if s.cache.flights[key] == f {
+		delete(s.cache.flights, key)
+		if err == nil {
+			if options.RecomputeTime <= 0 {
+				options.RecomputeTime = s.cache.clock.Now().Sub(f.started)
+			}
+			s.cache.PutWithOptions(key, value, options)
+		} else if s.cache.negativeTTL > 0 {
+			s.cache.failures[key] = failure{err: err, expiresAt: s.cache.clock.Now().Add(s.cache.negativeTTL)}
+		}
+	}
+	s.unlock()
+	f.land(value, err)
+	return value, err
+}
+
+func call[K comparable, V any](load func(key K) (V, error), key K) (value V, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("lru: loading %v panicked: %v", key, r)
+		}
+	}()
+	return load(key)
+}
+
+// Lease is GetOrLoad for callers that load values themselves, such as
+// remote clients. On a hit it returns the value and a zero token. The first
+// caller to miss gets a lease instead: a non-zero token to pass to Fill
+// with the loaded value within timeout. Callers that miss while the lease
+// is held wait for the fill until ctx ends and then get ErrLeaseHeld; if
+// the lease runs out first, the next caller gets a new one.
+func (sharded *Sharded[K, V]) Lease(ctx context.Context, key K, timeout time.Duration) (value V, token uint64, err error) {
+	s := sharded.shardFor(key)
+	for {
+		s.Lock()
+		if value, ok := s.cache.Get(key); ok {
+			s.unlock()
+			return value, 0, nil
+		}
+		if err := s.cache.failed(key); err != nil {
+			s.unlock()
+			return value, 0, err
+		}
+		f, left, ok := s.cache.inFlight(key)
+		if !ok {
+			break
+		}
+		s.unlock()
+
+		value, err := f.wait(ctx, left)
+		switch {
+		case errors.Is(err, ErrLeaseRevoked):
+			continue
+		case err != nil && err == ctx.Err():
+			return value, 0, ErrLeaseHeld
+		}
+		return value, 0, err
+	}
+	token = sharded.token()
+	s.cache.takeOff(key, token, timeout)
+	s.unlock()
+	return value, token, nil
+}
+
+// token returns a new lease token, which is never zero.
+func (sharded *Sharded[K, V]) token() uint64 {
+	token := sharded.tokens.Add(1)
+	if token == 0 {
+		token = sharded.tokens.Add(1)
+	}
+	return token
+}
+
+// Fill stores the value loaded by the holder of the lease token, hands it to
+// the callers waiting for it and returns its version. The time since the
+// lease was granted is recorded as the recompute time of the entry, unless
+// options has one. If the lease is no longer valid, the value is dropped and
+// Fill returns ErrLeaseRevoked.
+func (sharded *Sharded[K, V]) Fill(key K, token uint64, value V, options PutOptions) (uint64, error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+
+	f, ok := s.cache.flights[key]
+	if !ok || token == 0 || f.token != token {
+		return 0, ErrLeaseRevoked
+	}
+	if !s.cache.clock.Now().Before(f.expiresAt) {
+		s.cache.revoke(key)
+		return 0, ErrLeaseRevoked
+	}
+	delete(s.cache.flights, key)
+	f.land(value, nil)
+	if options.RecomputeTime <= 0 {
+		options.RecomputeTime = s.cache.clock.Now().Sub(f.started)
+	}
+	return s.cache.PutWithOptions(key, value, options)
+}
@@ -0,0 +1,149 @@
+package lru
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLeaseExpiresAndPassesOn(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewSharded(0, 1, WithClock[string, string](clock))
+
+	_, first, err := cache.Lease(t.Context(), "report", time.Second)
+	if err != nil || first == 0 {
+		t.Fatalf("Lease = %d, %v, want a token", first, err)
+	}
+	clock.advance(time.Second)
+	_, second, err := cache.Lease(t.Context(), "report", time.Second)
+	if err != nil || second == 0 || second == first {
+		t.Fatalf("Lease after expiry = %d, %v, want a new token", second, err)
+	}
+
+	if _, err := cache.Fill("report", first, "late", PutOptions{}); !errors.Is(err, ErrLeaseRevoked) {
+		t.Fatalf("Fill with the expired token = %v, want ErrLeaseRevoked", err)
+	}
+	if _, err := cache.Fill("report", second, "v1", PutOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if value, token, _ := cache.Lease(t.Context(), "report", time.Second); value != "v1" || token != 0 {
+		t.Fatalf("Lease after Fill = %q, %d, want a hit", value, token)
+	}
+}
+
+func TestFillAfterRevocation(t *testing.T) {
+	cache := NewSharded[string, string](0, 1)
+	for name, revoke := range map[string]func(){
+		"put":    func() { cache.Put("report", "direct") },
+		"remove": func() { cache.Remove("report") },
+	} {
+		cache.Remove("report")
+		_, token, _ := cache.Lease(t.Context(), "report", time.Minute)
+		revoke()
+		if _, err := cache.Fill("report", token, "loaded", PutOptions{}); !errors.Is(err, ErrLeaseRevoked) {
+			t.Fatalf("Fill after %s = %v, want ErrLeaseRevoked", name, err)
+		}
+		if value, _ := cache.Get("report"); value == "loaded" {
+			t.Fatalf("Get after %s = %q, want the revoked fill dropped", name, value)
+		}
+	}
+}
+
+func TestLeaseWaitersGetTheFill(t *testing.T) {
+	cache := NewSharded[string, string](0, 1)
+	_, token, _ := cache.Lease(t.Context(), "report", time.Minute)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := cache.Lease(ctx, "report", time.Minute); !errors.Is(err, ErrLeaseHeld) {
+		t.Fatalf("Lease while held = %v, want ErrLeaseHeld", err)
+	}
+
+	type leased struct {
+		value string
+		token uint64
+		err   error
+	}
+	waiter := make(chan leased)
+	go func() {
+		value, token, err := cache.Lease(t.Context(), "report", time.Minute)
+		waiter <- leased{value, token, err}
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cache.Fill("report", token, "v1", PutOptions{})
+	if got := <-waiter; got.value != "v1" || got.token != 0 || got.err != nil {
+		t.Fatalf("waiting Lease = %+v, want the filled value", got)
+	}
+}
+
+func TestGetOrLoadNegativeTTL(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewSharded(0, 1, WithClock[string, string](clock), WithNegativeTTL[string, string](time.Minute))
+	errMissing := errors.New("no such note")
+	loads := 0
+	load := func(string) (string, error) {
+		loads++
+		return "", errMissing
+	}
+
+	for range 2 {
+		if _, err := cache.GetOrLoad(t.Context(), "notes:1", load, PutOptions{}); !errors.Is(err, errMissing) {
+			t.Fatalf("GetOrLoad = %v, want the load error", err)
+		}
+	}
+	if loads != 1 {
+		t.Fatalf("%d loads, want the failure remembered", loads)
+	}
+	clock.advance(time.Minute)
+	cache.GetOrLoad(t.Context(), "notes:1", load, PutOptions{})
+	if loads != 2 {
+		t.Fatalf("%d loads, want a new load once the negative TTL passed", loads)
+	}
+}
+
+func TestGetOrLoadPanicIsError(t *testing.T) {
+	cache := NewSharded[string, string](0, 1)
+	_, err := cache.GetOrLoad(t.Context(), "notes:1", func(string) (string, error) {
+		panic("backend exploded")
+	}, PutOptions{})
+	if err == nil || !strings.Contains(err.Error(), "backend exploded") {
+		t.Fatalf("GetOrLoad = %v, want the panic as an error", err)
+	}
+	if cache.Contains("notes:1") {
+		t.Fatal("a panicking load stored a value")
+	}
+}
+
+func TestGetOrLoadRefreshesStaleInBackground(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewSharded(0, 1, WithClock[string, string](clock))
+	options := PutOptions{TTL: time.Hour, SoftTTL: time.Minute}
+	cache.PutWithOptions("notes:1", "v1", options)
+	clock.advance(time.Minute)
+
+	release, loaded := make(chan struct{}), make(chan struct{})
+	load := func(string) (string, error) {
+		<-release
+		defer close(loaded)
+		return "v2", nil
+	}
+	if value, err := cache.GetOrLoad(t.Context(), "notes:1", load, options); value != "v1" || err != nil {
+		t.Fatalf("GetOrLoad = %q, %v, want the stale value right away", value, err)
+	}
+	// The refresh is in flight, so nobody else starts one.
+	if value, _ := cache.GetOrLoad(t.Context(), "notes:1", load, options); value != "v1" {
+		t.Fatalf("GetOrLoad during the refresh = %q, want v1", value)
+	}
+	close(release)
+	<-loaded
+
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); {
+		if value, _ := cache.Peek("notes:1"); value == "v2" {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("the background refresh didn't store v2")
+}
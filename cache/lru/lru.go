@@ -1,68 +1,727 @@
 package lru
 
 import (
-	"container/list"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+	"unsafe"
 )
 
-type Cache struct {
-	capacity int
-	list     *list.List
-	elements map[string]*list.Element
+// ErrEntryTooLarge is returned by Put when a single entry is bigger than the
+// whole byte budget of the cache, so no amount of eviction would make it fit.
+var ErrEntryTooLarge = errors.New("lru: entry is larger than the cache budget")
+
+// ErrNotFound is returned by operations on a single entry when the key is
+// not cached.
+var ErrNotFound = errors.New("lru: key not found")
+
+// entryOverhead approximates the bookkeeping bytes each entry costs on top of
+// its key and value: the list node holding the KeyPair, its slot in the
+// elements map, and the list node and map slot the list based policies keep
+// for the key. Map slots are counted at the 7/8 load of a full map, with
+// their control byte.
+func entryOverhead[K comparable, V any]() int64 {
+	slot := func(size uintptr) int64 {
+		return int64(size)*8/7 + 1
+	}
+	elements := slot(unsafe.Sizeof(struct {
+		key K
+		i   int32
+	}{}))
+	return int64(unsafe.Sizeof(indexNode[KeyPair[K, V]]{})) + elements +
+		int64(unsafe.Sizeof(indexNode[K]{})) + elements
+}
+
+// Cache maps keys to values and evicts entries once it is over capacity or
+// over its byte budget. It is not safe for concurrent use; see Sharded.
+type Cache[K comparable, V any] struct {
+	// budget bounds the number of entries and their total size, and bytes
+	// is the size of the entries of this cache.
+	budget *budget
+	bytes  int64
+	// overhead is the entryOverhead added to the size of every entry.
+	overhead int64
+	// entries holds the KeyPairs in recency order, most recent first, and
+	// elements maps each key to its slot in entries.
+	entries  indexList[KeyPair[K, V]]
+	elements map[K]int32
+	expiring map[K]struct{}
+	clock    Clock
+	// policies holds one instance of the eviction policy per Priority, and
+	// tracked and trackedBytes the number and size of the entries each of
+	// them tracks.
+	policies     [High - Low + 1]Policy[K]
+	tracked      [High - Low + 1]int
+	trackedBytes [High - Low + 1]int64
+	newPolicy    func() Policy[K]
+	sizer        func(K, V) int64
+	stats        Stats
+
+	callbacks []EvictionCallback[K, V]
+	pending   []eviction[K, V]
+	// deferred leaves the pending callbacks to the owner of the cache.
+	deferred bool
+	// spilled is set when a put took the budget over its bounds because this
+	// cache had nothing left to evict, while other caches sharing the budget
+	// have entries of at most spill, the priority the put could evict.
+	// Sharded evicts those once the shard is unlocked.
+	spilled bool
+	spill   Priority
+
+	// flights holds the loads of missing keys in progress and failures the
+	// recent failed ones, for GetOrLoad and Lease.
+	flights     map[K]*flight[V]
+	failures    map[K]failure
+	negativeTTL time.Duration
+	// beta scales how early entries are refreshed; see refreshDue.
+	beta float64
+
+	// prefixes indexes the keys by prefix, if the cache was built
+	// WithPrefixIndex, and keyString gives the string of a key.
+	prefixes  *radixTree[K]
+	keyString func(K) string
+
+	// pinned counts the entries of this cache that are pinned and left out
+	// of the policy, and pins the pinned capacity they count against.
+	pinned int
+	pins   *pinQuota
+
+	// version is the version of the last put. Every put gets a higher one.
+	version uint64
+
+	maxFields int
+}
+
+// Stats counts how the cache has been doing since it was created.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+	Bytes     int64
+	Pinned    int
+	// Segments holds the number of keys in each segment of the policy, if
+	// the policy is Segmented.
+	Segments map[string]int
+}
+
+// HitRatio is the share of reads that found their key.
+func (stats Stats) HitRatio() float64 {
+	if stats.Hits+stats.Misses == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(stats.Hits+stats.Misses)
+}
+
+type KeyPair[K comparable, V any] struct {
+	key   K
+	value V
+	size  int64
+	// expiresAt is the deadline after which the entry is dropped. The zero
+	// value means the entry never expires.
+	expiresAt time.Time
+	// accessed is when the entry was last read or written. Sharded uses it
+	// to order keys across shards.
+	accessed time.Time
+	// delta is how long the value took to compute, for early refresh.
+	delta time.Duration
+	// staleAt is the soft deadline after which the entry is still served but
+	// should be refreshed. The zero value means it never goes stale.
+	staleAt time.Time
+	// slide is the TTL a read pushes expiresAt forward by, for sliding
+	// expiration, and maxIdle drops the entry once it has not been read for
+	// that long. Zero turns either off.
+	slide   time.Duration
+	maxIdle time.Duration
+	// cost is the cost of computing the value, for Weighted policies.
+	cost float64
+	// pinned entries are not tracked by the policy, so they are never
+	// evicted.
+	pinned   bool
+	priority Priority
+	// version tells successive values of a key apart; see CompareAndSet.
+	version uint64
+}
+
+func (pair *KeyPair[K, V]) expired(now time.Time) bool {
+	return (!pair.expiresAt.IsZero() && !now.Before(pair.expiresAt)) ||
+		(pair.maxIdle > 0 && now.Sub(pair.accessed) >= pair.maxIdle)
+}
+
+func (pair *KeyPair[K, V]) stale(now time.Time) bool {
+	return !pair.staleAt.IsZero() && !now.Before(pair.staleAt)
+}
+
+// Clock is the time source used for expiry. Tests can inject a fake one to
+// check expiration without sleeping.
+type Clock interface {
+	Now() time.Time
 }
 
-type KeyPair struct {
-	key   string
-	value string
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type Option[K comparable, V any] func(*Cache[K, V])
+
+// WithMaxBytes bounds the cache by the estimated memory its entries use,
+// counting key, value and per-entry overhead.
+func WithMaxBytes[K comparable, V any](maxBytes int64) Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.budget.maxBytes = maxBytes
+	}
 }
 
-func New(capacity int) Cache {
-	return Cache{
-		capacity: capacity,
-		list:     new(list.List),
-		elements: make(map[string]*list.Element, capacity),
+// WithSizer sets how many bytes a key and value take, for caches bounded by
+// WithMaxBytes. By default strings and byte slices count their length and
+// other types their static size.
+func WithSizer[K comparable, V any](sizer func(key K, value V) int64) Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.sizer = sizer
 	}
 }
 
-func (cache *Cache) Get(key string) (string, bool) {
-	if node, ok := cache.elements[key]; ok {
-		value := node.Value.(*list.Element).Value.(KeyPair).value
-		cache.list.MoveToFront(node)
-		return value, true
+// WithPolicy sets the eviction policy. It takes a constructor rather than a
+// Policy so every shard of a Sharded cache gets its own instance.
+func WithPolicy[K comparable, V any](newPolicy func() Policy[K]) Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.newPolicy = newPolicy
 	}
-	return "", false
 }
 
-func (cache *Cache) Put(key string, value string) {
-	if node, ok := cache.elements[key]; ok {
-		cache.list.MoveToFront(node)
-		node.Value.(*list.Element).Value = KeyPair{key: key, value: value}
+func WithClock[K comparable, V any](clock Clock) Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.clock = clock
+	}
+}
+
+func New[K comparable, V any](capacity int, options ...Option[K, V]) Cache[K, V] {
+	cache := Cache[K, V]{
+		budget:    &budget{},
+		overhead:  entryOverhead[K, V](),
+		entries:   newIndexList[KeyPair[K, V]](capacity),
+		elements:  make(map[K]int32, capacity),
+		expiring:  make(map[K]struct{}),
+		clock:     systemClock{},
+		sizer:     defaultSize[K, V],
+		flights:   make(map[K]*flight[V]),
+		failures:  make(map[K]failure),
+		beta:      1,
+		pins:      &pinQuota{},
+		newPolicy: NewLRU[K],
+	}
+	cache.budget.capacity.Store(int64(capacity))
+	for _, option := range options {
+		option(&cache)
+	}
+	for i := range cache.policies {
+		cache.policies[i] = cache.newPolicy()
+	}
+	return cache
+}
+
+func defaultSize[K comparable, V any](key K, value V) int64 {
+	return sizeOf(key) + sizeOf(value)
+}
+
+func sizeOf[T any](v T) int64 {
+	switch v := any(v).(type) {
+	case string:
+		return int64(len(v))
+	case []byte:
+		return int64(len(v))
+	}
+	return int64(unsafe.Sizeof(v))
+}
+
+// Get returns the value for key. Entries past their soft TTL are still
+// returned; see Sharded.Fetch to tell them apart.
+func (cache *Cache[K, V]) Get(key K) (V, bool) {
+	if pair, ok := cache.get(key); ok {
+		return pair.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// get looks key up as a read, updating its recency, the policy and the
+// statistics. The pointer is only valid until the cache is modified.
+func (cache *Cache[K, V]) get(key K) (*KeyPair[K, V], bool) {
+	if i, ok := cache.elements[key]; ok {
+		pair := cache.entries.at(i)
+		now := cache.clock.Now()
+		if !pair.expired(now) {
+			pair.accessed = now
+			if pair.slide > 0 {
+				pair.expiresAt = now.Add(pair.slide)
+			}
+			cache.entries.moveToFront(i)
+			if !pair.pinned {
+				cache.policyOf(pair.priority).Access(key)
+			}
+			cache.stats.Hits++
+			return pair, true
+		}
+		cache.remove(key, Expired)
+		cache.flush()
+	}
+	cache.stats.Misses++
+	return nil, false
+}
+
+// Peek returns the value for key without updating its recency, the policy
+// or the hit statistics.
+func (cache *Cache[K, V]) Peek(key K) (V, bool) {
+	if i, ok := cache.elements[key]; ok {
+		if pair := cache.entries.at(i); !pair.expired(cache.clock.Now()) {
+			return pair.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether key is cached, without counting as a use.
+func (cache *Cache[K, V]) Contains(key K) bool {
+	_, ok := cache.Peek(key)
+	return ok
+}
+
+// Len returns the number of entries, including expired ones that have not
+// been dropped yet.
+func (cache *Cache[K, V]) Len() int {
+	return cache.entries.len()
+}
+
+// Keys returns the keys that have not expired, from the most to the least
+// recently used.
+func (cache *Cache[K, V]) Keys() []K {
+	pairs := cache.pairs()
+	keys := make([]K, len(pairs))
+	for i, pair := range pairs {
+		keys[i] = pair.key
+	}
+	return keys
+}
+
+// pairs returns the entries that have not expired, most recent first.
+func (cache *Cache[K, V]) pairs() []KeyPair[K, V] {
+	now := cache.clock.Now()
+	pairs := make([]KeyPair[K, V], 0, cache.entries.len())
+	for i := cache.entries.front(); i != 0; i = cache.entries.next(i) {
+		if pair := cache.entries.at(i); !pair.expired(now) {
+			pairs = append(pairs, *pair)
+		}
+	}
+	return pairs
+}
+
+// Resize changes the capacity and evicts entries until the cache fits in
+// it. It returns how many entries were evicted.
+func (cache *Cache[K, V]) Resize(capacity int) (evicted int) {
+	defer cache.flush()
+	cache.budget.capacity.Store(int64(capacity))
+	for cache.budget.over() && cache.evict(High) {
+		evicted++
+	}
+	return evicted
+}
+
+func (cache *Cache[K, V]) Put(key K, value V) error {
+	_, err := cache.PutWithOptions(key, value, PutOptions{})
+	return err
+}
+
+// PutWithTTL stores the value like Put and drops it once ttl has passed.
+// A ttl of zero or less keeps the entry until it is evicted or removed.
+func (cache *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) error {
+	_, err := cache.PutWithOptions(key, value, PutOptions{TTL: ttl})
+	return err
+}
+
+// PutOptions describes how a single entry is stored. The zero value stores
+// it like Put.
+type PutOptions struct {
+	// TTL drops the entry once it has passed. Zero or less keeps the entry
+	// until it is evicted or removed.
+	TTL time.Duration
+	// SoftTTL marks the entry stale once it has passed. A stale entry is
+	// still served until TTL, but readers are told to refresh it. It is
+	// ignored unless it is shorter than TTL.
+	SoftTTL time.Duration
+	// Sliding pushes the TTL deadline forward on every read, so the entry
+	// expires TTL after it was last read rather than after it was stored.
+	Sliding bool
+	// MaxIdle drops the entry once it has not been read for that long, even
+	// if its TTL has not passed. Zero or less doesn't limit idle time.
+	MaxIdle time.Duration
+	// Cost is how expensive the value was to compute, in any unit as long
+	// as it is the same for all entries. Weighted policies keep costly
+	// entries longer; costs below one count as one.
+	Cost float64
+	// RecomputeTime is how long the value took to compute. Together with a
+	// TTL it lets Sharded.Fetch and GetOrLoad refresh the entry shortly
+	// before it expires.
+	RecomputeTime time.Duration
+	// Pinned exempts the entry from eviction; see Cache.Pin. Overwriting a
+	// pinned entry keeps it pinned.
+	Pinned bool
+	// Priority is the eviction class of the entry.
+	Priority Priority
+}
+
+// PutWithOptions stores the value like Put and returns the version it was
+// stored with.
+func (cache *Cache[K, V]) PutWithOptions(key K, value V, options PutOptions) (uint64, error) {
+	defer cache.flush()
+	cache.revoke(key)
+	now := cache.clock.Now()
+	pair := KeyPair[K, V]{key: key, value: value, accessed: now, delta: options.RecomputeTime}
+	pair.size = cache.sizer(key, value) + cache.overhead
+	pair.cost = max(options.Cost, 1)
+	pair.priority = min(max(options.Priority, Low), High)
+	if maxBytes := cache.budget.maxBytes; maxBytes > 0 && pair.size > maxBytes {
+		return 0, fmt.Errorf("%w: %d bytes, budget is %d bytes", ErrEntryTooLarge, pair.size, maxBytes)
+	}
+
+	pinnedBefore, live := false, false
+	if i, ok := cache.elements[key]; ok {
+		old := cache.entries.at(i)
+		pinnedBefore, live = old.pinned, !old.expired(now)
+	}
+	pair.pinned = options.Pinned || (pinnedBefore && live)
+	if pair.pinned && !pinnedBefore {
+		if !cache.pins.acquire() {
+			return 0, ErrPinnedQuota
+		}
+		cache.pinned++
+	}
+
+	// Pinned entries are within their own quota, so they may evict anything.
+	limit := pair.priority
+	if pair.pinned {
+		limit = High
+	}
+	if !cache.makeRoom(key, pair.size, limit) {
+		if pair.pinned && !pinnedBefore {
+			cache.pinned--
+			cache.pins.release(1)
+		}
+		return 0, ErrNoRoom
+	}
+
+	if options.TTL > 0 {
+		pair.expiresAt = now.Add(options.TTL)
+		if options.Sliding {
+			pair.slide = options.TTL
+		}
+	}
+	pair.maxIdle = max(options.MaxIdle, 0)
+	if options.TTL > 0 || pair.maxIdle > 0 {
+		cache.expiring[key] = struct{}{}
 	} else {
-		if cache.list.Len() == cache.capacity {
-			idx := cache.list.Back().Value.(*list.Element).Value.(KeyPair).key
-			delete(cache.elements, idx)
-			cache.list.Remove(cache.list.Back())
+		delete(cache.expiring, key)
+	}
+	if options.SoftTTL > 0 && (options.TTL <= 0 || options.SoftTTL < options.TTL) {
+		pair.staleAt = now.Add(options.SoftTTL)
+	}
+	cache.version++
+	pair.version = cache.version
+
+	if i, ok := cache.elements[key]; ok {
+		old := *cache.entries.at(i)
+		cache.entries.moveToFront(i)
+		cache.account(0, -old.size)
+		*cache.entries.at(i) = pair
+		switch {
+		case old.pinned && !pair.pinned:
+			// The old entry expired while pinned; its pin went with it.
+			cache.pinned--
+			cache.pins.release(1)
+			cache.track(&pair)
+		case !old.pinned && pair.pinned:
+			cache.untrack(&old)
+		case !pair.pinned && old.priority != pair.priority:
+			cache.untrack(&old)
+			cache.track(&pair)
+		case !pair.pinned:
+			cache.policyOf(pair.priority).Access(key)
+			cache.tally(pair.priority, 0, pair.size-old.size)
+			cache.weigh(&pair)
+		}
+		cache.evicted(old, Replaced)
+	} else {
+		cache.elements[key] = cache.entries.pushFront(pair)
+		cache.account(1, 0)
+		if !pair.pinned {
+			cache.track(&pair)
+		}
+		if cache.prefixes != nil {
+			cache.prefixes.insert(cache.keyString(key), key)
+		}
+	}
+	cache.account(0, pair.size)
+	return pair.version, nil
+}
+
+// replace stores a new value for the live entry of key, keeping its
+// deadlines and the rest of its options, and returns the new version.
+func (cache *Cache[K, V]) replace(key K, value V) (uint64, error) {
+	old := *cache.entries.at(cache.elements[key])
+	version, err := cache.PutWithOptions(key, value, PutOptions{
+		TTL:           old.expiresAt.Sub(cache.clock.Now()),
+		MaxIdle:       old.maxIdle,
+		Cost:          old.cost,
+		RecomputeTime: old.delta,
+		Pinned:        old.pinned,
+		Priority:      old.priority,
+	})
+	if err != nil {
+		return 0, err
+	}
+	pair := cache.entries.at(cache.elements[key])
+	pair.expiresAt, pair.slide, pair.staleAt = old.expiresAt, old.slide, old.staleAt
+	return version, nil
+}
+
+// weigh reports the size and cost of an entry the policy tracks to policies
+// that are Weighted.
+func (cache *Cache[K, V]) weigh(pair *KeyPair[K, V]) {
+	if weighted, ok := cache.policyOf(pair.priority).(Weighted[K]); ok && !pair.pinned {
+		weighted.Weigh(pair.key, pair.size, pair.cost)
+	}
+}
+
+// makeRoom evicts victims of at most the given priority until an entry of
+// the given size can be stored under key. An existing entry for key is about
+// to be replaced, so its own slot and bytes count as free. If the policy
+// picks that entry as a victim it is evicted too, and the put goes on as an
+// insert. makeRoom reports false, without evicting anything, if the entry
+// doesn't fit even with every entry up to the given priority gone while
+// entries of a higher priority are left. The tiers are counted over the
+// whole budget, so in a Sharded cache the entries that would make room may
+// be in other shards; once this cache has nothing left to evict, it goes
+// over its bounds and sets spilled so Sharded evicts them. It also goes over
+// its bounds when only pinned entries are left.
+func (cache *Cache[K, V]) makeRoom(key K, size int64, limit Priority) bool {
+	entries, bytes := cache.budget.entries.Load()+1, cache.budget.bytes.Load()+size
+	var old *KeyPair[K, V]
+	if i, ok := cache.elements[key]; ok {
+		old = cache.entries.at(i)
+		entries--
+		bytes -= old.size
+	}
+	if !cache.budget.overflows(entries, bytes) {
+		return true
+	}
+	if cache.higherTracked(limit) {
+		for priority := Low; priority <= limit; priority++ {
+			entries -= cache.budget.tracked[priority-Low].Load()
+			bytes -= cache.budget.trackedBytes[priority-Low].Load()
+		}
+		// The slot of the old entry was counted as free already.
+		if old != nil && !old.pinned && old.priority <= limit {
+			entries++
+			bytes += old.size
+		}
+		if cache.budget.overflows(entries, bytes) {
+			return false
 		}
 	}
 
-	node := &list.Element{
-		Value: KeyPair{
-			key:   key,
-			value: value,
-		},
+	for {
+		entries, bytes := cache.budget.entries.Load()+1, cache.budget.bytes.Load()+size
+		if i, ok := cache.elements[key]; ok {
+			entries--
+			bytes -= cache.entries.at(i).size
+		}
+		if !cache.budget.overflows(entries, bytes) {
+			return true
+		}
+		if !cache.evict(limit) {
+			for priority := Low; priority <= limit; priority++ {
+				if cache.budget.tracked[priority-Low].Load() > 0 {
+					if !cache.spilled || limit > cache.spill {
+						cache.spill = limit
+					}
+					cache.spilled = true
+					break
+				}
+			}
+			return true
+		}
 	}
+}
 
-	pointer := cache.list.PushFront(node)
-	cache.elements[key] = pointer
+// higherTracked reports whether the policies above the given priority
+// track any entries, in any cache sharing the budget.
+func (cache *Cache[K, V]) higherTracked(limit Priority) bool {
+	for priority := limit + 1; priority <= High; priority++ {
+		if cache.budget.tracked[priority-Low].Load() > 0 {
+			return true
+		}
+	}
+	return false
 }
 
-func (cache *Cache) Clear() {
-	cache.list = new(list.List)
-	cache.elements = make(map[string]*list.Element, cache.capacity)
+// evict removes the victim of the lowest priority policy, up to limit, that
+// has one. It returns false if they have nothing left to evict.
+func (cache *Cache[K, V]) evict(limit Priority) bool {
+	for priority := Low; priority <= limit; priority++ {
+		if cache.evictFrom(priority) {
+			return true
+		}
+	}
+	return false
 }
 
-func (cache *Cache) Remove(key string) {
-	if node, ok := cache.elements[key]; ok {
+// evictFrom removes the victim of the policy of the given priority, if it
+// has one.
+func (cache *Cache[K, V]) evictFrom(priority Priority) bool {
+	victim, ok := cache.policyOf(priority).Victim()
+	if ok {
+		cache.remove(victim, Capacity)
+		cache.stats.Evictions++
+	}
+	return ok
+}
+
+// budget bounds the number of entries and their total size. A bound of
+// zero is not enforced. The shards of a Sharded cache share one, so the
+// bounds hold for the cache as a whole and no shard evicts before the whole
+// cache is full, however unevenly the keys are spread.
+type budget struct {
+	capacity atomic.Int64
+	maxBytes int64
+	entries  atomic.Int64
+	bytes    atomic.Int64
+	// tracked and trackedBytes add up the entries each priority tracks in
+	// all the caches sharing the budget.
+	tracked      [High - Low + 1]atomic.Int64
+	trackedBytes [High - Low + 1]atomic.Int64
+}
+
+func (budget *budget) overflows(entries int64, bytes int64) bool {
+	capacity := budget.capacity.Load()
+	return (capacity > 0 && entries > capacity) ||
+		(budget.maxBytes > 0 && bytes > budget.maxBytes)
+}
+
+// over reports whether the entries held now are over the bounds.
+func (budget *budget) over() bool {
+	return budget.overflows(budget.entries.Load(), budget.bytes.Load())
+}
+
+// account records entries and bytes added to the cache, or removed from it
+// if they are negative.
+func (cache *Cache[K, V]) account(entries int, bytes int64) {
+	cache.bytes += bytes
+	cache.budget.entries.Add(int64(entries))
+	cache.budget.bytes.Add(bytes)
+}
+
+func (cache *Cache[K, V]) Clear() {
+	defer cache.flush()
+	if len(cache.callbacks) > 0 {
+		for i := cache.entries.back(); i != 0; i = cache.entries.prev(i) {
+			cache.evicted(*cache.entries.at(i), Cleared)
+		}
+	}
+
+	for key := range cache.flights {
+		cache.revoke(key)
+	}
+	cache.account(-cache.entries.len(), -cache.bytes)
+	cache.entries.clear()
+	cache.elements = make(map[K]int32)
+	cache.expiring = make(map[K]struct{})
+	cache.failures = make(map[K]failure)
+	cache.pins.release(cache.pinned)
+	cache.pinned = 0
+	for i, policy := range cache.policies {
+		policy.Clear()
+		cache.tally(Low+Priority(i), -cache.tracked[i], -cache.trackedBytes[i])
+	}
+	if cache.prefixes != nil {
+		cache.prefixes.clear()
+	}
+}
+
+func (cache *Cache[K, V]) Remove(key K) {
+	cache.revoke(key)
+	cache.remove(key, Removed)
+	cache.flush()
+}
+
+func (cache *Cache[K, V]) remove(key K, reason EvictionReason) {
+	if i, ok := cache.elements[key]; ok {
+		pair := *cache.entries.at(i)
+		cache.account(-1, -pair.size)
 		delete(cache.elements, key)
-		cache.list.Remove(node)
+		delete(cache.expiring, key)
+		cache.entries.remove(i)
+		if pair.pinned {
+			cache.pinned--
+			cache.pins.release(1)
+		} else {
+			cache.untrack(&pair)
+		}
+		if cache.prefixes != nil {
+			cache.prefixes.remove(cache.keyString(key))
+		}
+		cache.evicted(pair, reason)
+	}
+}
+
+func (cache *Cache[K, V]) Stats() Stats {
+	stats := cache.stats
+	stats.Entries = cache.entries.len()
+	stats.Bytes = cache.bytes
+	stats.Pinned = cache.pinned
+	for _, policy := range cache.policies {
+		segmented, ok := policy.(Segmented)
+		if !ok {
+			continue
+		}
+		for segment, keys := range segmented.Segments() {
+			if stats.Segments == nil {
+				stats.Segments = make(map[string]int)
+			}
+			stats.Segments[segment] += keys
+		}
+	}
+	return stats
+}
+
+// sweep checks up to samples keys that carry a TTL or a max idle time and
+// removes the ones that have expired. It returns how many keys it checked
+// and removed. Map iteration order is random, so repeated calls sample
+// different keys.
+func (cache *Cache[K, V]) sweep(samples int) (checked int, removed int) {
+	now := cache.clock.Now()
+	for key := range cache.expiring {
+		if checked == samples {
+			break
+		}
+		checked++
+		if cache.entries.at(cache.elements[key]).expired(now) {
+			cache.remove(key, Expired)
+			removed++
+		}
+	}
+
+	pruned := 0
+	for key, failure := range cache.failures {
+		if pruned == samples {
+			break
+		}
+		pruned++
+		if !now.Before(failure.expiresAt) {
+			delete(cache.failures, key)
+		}
 	}
+	return checked, removed
 }
@@ -0,0 +1,130 @@
+package lru
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock that only moves when told to, or by tick on every
+// reading if tick is set.
+type fakeClock struct {
+	now  time.Time
+	tick time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1_700_000_000, 0)}
+}
+
+func (clock *fakeClock) Now() time.Time {
+	now := clock.now
+	clock.now = clock.now.Add(clock.tick)
+	return now
+}
+
+func (clock *fakeClock) advance(d time.Duration) {
+	clock.now = clock.now.Add(d)
+}
+
+func TestExpiryIsLazy(t *testing.T) {
+	clock := newFakeClock()
+	cache := New(0, WithClock[string, int](clock))
+	var reasons []EvictionReason
+	cache.OnEvict(func(_ string, _ int, reason EvictionReason) {
+		reasons = append(reasons, reason)
+	})
+	cache.PutWithTTL("session", 1, time.Minute)
+	cache.Put("config", 2)
+
+	clock.advance(time.Minute - time.Second)
+	if _, ok := cache.Get("session"); !ok {
+		t.Fatal("session expired before its TTL")
+	}
+	clock.advance(time.Second)
+	if _, ok := cache.Peek("session"); ok {
+		t.Fatal("Peek returned an expired entry")
+	}
+	// Nothing has dropped the entry yet.
+	if n := cache.Len(); n != 2 {
+		t.Fatalf("Len() = %d before the expired entry was read, want 2", n)
+	}
+	if _, ok := cache.Get("session"); ok {
+		t.Fatal("Get returned an expired entry")
+	}
+	if n := cache.Len(); n != 1 || len(reasons) != 1 || reasons[0] != Expired {
+		t.Fatalf("Len() = %d and evictions %v after Get, want 1 and [expired]", n, reasons)
+	}
+}
+
+func TestSweepRemovesExpired(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewSharded(0, 1, WithClock[string, int](clock))
+	for i := range 100 {
+		ttl := time.Minute
+		if i%2 == 0 {
+			ttl = time.Hour
+		}
+		cache.PutWithTTL("session:"+strconv.Itoa(i), i, ttl)
+	}
+	cache.Put("config", 0)
+	clock.advance(2 * time.Minute)
+
+	cache.shards[0].sweep()
+	if n := cache.Len(); n == 101 || n < 51 {
+		t.Fatalf("Len() = %d after a sweep of 101 keys, 50 of them expired", n)
+	}
+	for range 100 {
+		cache.shards[0].cache.sweep(sweepSamples)
+	}
+	if n := cache.Len(); n != 51 {
+		t.Fatalf("Len() = %d after sweeping everything, want 51", n)
+	}
+	if !cache.Contains("config") {
+		t.Fatal("sweep removed a key without a TTL")
+	}
+}
+
+func TestSlidingTTL(t *testing.T) {
+	clock := newFakeClock()
+	cache := New(0, WithClock[string, int](clock))
+	cache.PutWithOptions("session", 1, PutOptions{TTL: time.Minute, Sliding: true})
+	cache.PutWithOptions("fixed", 2, PutOptions{TTL: time.Minute})
+
+	for range 3 {
+		clock.advance(40 * time.Second)
+		cache.Get("fixed")
+		if _, ok := cache.Get("session"); !ok {
+			t.Fatal("a sliding entry expired although it was read within its TTL")
+		}
+	}
+	if cache.Contains("fixed") {
+		t.Fatal("reads pushed back the TTL of an entry that doesn't slide")
+	}
+	// Peek is not a read and doesn't slide the deadline.
+	clock.advance(40 * time.Second)
+	cache.Peek("session")
+	clock.advance(20 * time.Second)
+	if cache.Contains("session") {
+		t.Fatal("session outlived its TTL after the last read")
+	}
+}
+
+func TestMaxIdle(t *testing.T) {
+	clock := newFakeClock()
+	cache := New(0, WithClock[string, int](clock))
+	cache.PutWithOptions("session", 1, PutOptions{TTL: time.Hour, MaxIdle: time.Minute})
+
+	clock.advance(50 * time.Second)
+	if _, ok := cache.Get("session"); !ok {
+		t.Fatal("session expired before it was idle for a minute")
+	}
+	clock.advance(50 * time.Second)
+	if _, ok := cache.Get("session"); !ok {
+		t.Fatal("a read didn't reset the idle time")
+	}
+	clock.advance(time.Minute)
+	if _, ok := cache.Get("session"); ok {
+		t.Fatal("session outlived its max idle time")
+	}
+}
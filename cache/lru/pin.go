@@ -0,0 +1,95 @@
+package lru
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ErrPinnedQuota is returned when pinning an entry would exceed the pinned
+// capacity of the cache.
+var ErrPinnedQuota = errors.New("lru: pinned capacity is used up")
+
+// WithPinnedCapacity bounds the number of pinned entries. Pinned entries are
+// never evicted, so the bound keeps them from taking over the cache. Zero
+// is not enforced, like the capacity.
+func WithPinnedCapacity[K comparable, V any](capacity int) Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.pins = &pinQuota{limit: int64(capacity)}
+	}
+}
+
+// pinQuota counts pinned entries against their limit. The shards of a
+// Sharded cache share one, so the limit holds for the cache as a whole.
+type pinQuota struct {
+	limit int64
+	used  atomic.Int64
+}
+
+func (quota *pinQuota) acquire() bool {
+	for {
+		used := quota.used.Load()
+		if quota.limit > 0 && used >= quota.limit {
+			return false
+		}
+		if quota.used.CompareAndSwap(used, used+1) {
+			return true
+		}
+	}
+}
+
+func (quota *pinQuota) release(n int) {
+	quota.used.Add(-int64(n))
+}
+
+// Pin exempts the entry for key from eviction until it is unpinned. It can
+// still expire or be removed. Pin returns ErrNotFound if the key is not
+// cached and ErrPinnedQuota if the pinned capacity is used up.
+func (cache *Cache[K, V]) Pin(key K) error {
+	i, ok := cache.elements[key]
+	if !ok || cache.entries.at(i).expired(cache.clock.Now()) {
+		return ErrNotFound
+	}
+	pair := cache.entries.at(i)
+	if pair.pinned {
+		return nil
+	}
+	if !cache.pins.acquire() {
+		return ErrPinnedQuota
+	}
+	cache.untrack(pair)
+	pair.pinned = true
+	cache.pinned++
+	return nil
+}
+
+// Unpin hands the entry for key back to the eviction policy, as if it had
+// just been inserted. It returns ErrNotFound if the key is not cached.
+func (cache *Cache[K, V]) Unpin(key K) error {
+	i, ok := cache.elements[key]
+	if !ok || cache.entries.at(i).expired(cache.clock.Now()) {
+		return ErrNotFound
+	}
+	pair := cache.entries.at(i)
+	if !pair.pinned {
+		return nil
+	}
+	pair.pinned = false
+	cache.pinned--
+	cache.pins.release(1)
+	cache.track(pair)
+	return nil
+}
+
+func (sharded *Sharded[K, V]) Pin(key K) error {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.Unlock()
+	return s.cache.Pin(key)
+}
+
+func (sharded *Sharded[K, V]) Unpin(key K) error {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.Unlock()
+	return s.cache.Unpin(key)
+}
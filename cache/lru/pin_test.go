@@ -0,0 +1,91 @@
+package lru
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPinnedEntriesAreNotEvicted(t *testing.T) {
+	cache := New[string, int](2)
+	cache.PutWithOptions("config", 0, PutOptions{Pinned: true})
+	for _, key := range []string{"a", "b", "c"} {
+		cache.Put(key, 0)
+	}
+	if !cache.Contains("config") || cache.Len() != 2 {
+		t.Fatalf("Keys() = %v, want config kept", cache.Keys())
+	}
+}
+
+func TestPinnedQuota(t *testing.T) {
+	cache := New(0, WithPinnedCapacity[string, int](2))
+	cache.PutWithOptions("a", 0, PutOptions{Pinned: true})
+	cache.Put("b", 0)
+	if err := cache.Pin("b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.PutWithOptions("c", 0, PutOptions{Pinned: true}); !errors.Is(err, ErrPinnedQuota) {
+		t.Fatalf("PutWithOptions over the quota = %v, want ErrPinnedQuota", err)
+	}
+	if cache.Contains("c") {
+		t.Fatal("a put over the quota was stored")
+	}
+	// Overwriting a pinned entry keeps its pin without taking another.
+	if _, err := cache.PutWithOptions("a", 1, PutOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Put("c", 0)
+	if err := cache.Pin("c"); !errors.Is(err, ErrPinnedQuota) {
+		t.Fatalf("Pin over the quota = %v, want ErrPinnedQuota", err)
+	}
+	cache.Unpin("a")
+	if err := cache.Pin("c"); err != nil {
+		t.Fatalf("Pin after Unpin = %v", err)
+	}
+}
+
+func TestPinnedQuotaIsReleased(t *testing.T) {
+	clock := newFakeClock()
+	cache := New(0, WithPinnedCapacity[string, int](1), WithClock[string, int](clock))
+	pin := func(key string, options PutOptions) error {
+		options.Pinned = true
+		_, err := cache.PutWithOptions(key, 0, options)
+		return err
+	}
+
+	pin("a", PutOptions{})
+	cache.Remove("a")
+	if err := pin("b", PutOptions{TTL: time.Minute}); err != nil {
+		t.Fatalf("pin after Remove = %v", err)
+	}
+	clock.advance(time.Minute)
+	cache.Get("b")
+	if err := pin("c", PutOptions{}); err != nil {
+		t.Fatalf("pin after expiry = %v", err)
+	}
+	cache.Clear()
+	if err := pin("d", PutOptions{}); err != nil {
+		t.Fatalf("pin after Clear = %v", err)
+	}
+	if stats := cache.Stats(); stats.Pinned != 1 {
+		t.Fatalf("Stats().Pinned = %d, want 1", stats.Pinned)
+	}
+}
+
+func TestPinnedQuotaIsSharedByShards(t *testing.T) {
+	cache := NewSharded(0, 4, WithPinnedCapacity[string, int](3))
+	keys := keysByShard(cache, 1)
+	for _, shardKeys := range keys[:3] {
+		if _, err := cache.PutWithOptions(shardKeys[0], 0, PutOptions{Pinned: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := cache.PutWithOptions(keys[3][0], 0, PutOptions{Pinned: true}); !errors.Is(err, ErrPinnedQuota) {
+		t.Fatalf("pin in a fourth shard = %v, want ErrPinnedQuota", err)
+	}
+	cache.Clear()
+	if _, err := cache.PutWithOptions(keys[3][0], 0, PutOptions{Pinned: true}); err != nil {
+		t.Fatalf("pin after Clear = %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package lru
+
+// Policy decides which entry the cache gives up when it is over capacity or
+// over its byte budget. The cache reports every key it inserts, reads and
+// drops, and asks for a Victim before it makes room for a new entry.
+//
+// A Policy only tracks keys; the cache owns the values. Policies are not
+// safe for concurrent use, the owning cache serialises calls to them.
+type Policy[K comparable] interface {
+	// Insert is called when a key is added to the cache.
+	Insert(key K)
+	// Access is called when a cached key is read or overwritten.
+	Access(key K)
+	// Victim returns the key that should be evicted next, without removing
+	// it. The cache calls Remove once the entry is gone.
+	Victim() (K, bool)
+	// Remove is called whenever a key leaves the cache, for any reason.
+	Remove(key K)
+	Clear()
+}
+
+// Segmented is implemented by policies that split their keys into
+// segments, so the cache statistics can report how full each one is.
+type Segmented interface {
+	Segments() map[string]int
+}
+
+// Weighted is implemented by policies that weigh keys by the size of their
+// entry and the cost of computing its value. The cache calls Weigh after it
+// inserts or overwrites a key.
+type Weighted[K comparable] interface {
+	Weigh(key K, size int64, cost float64)
+}
+
+// LRU evicts the least recently used key.
+type LRU[K comparable] struct {
+	keys keyList[K]
+}
+
+func NewLRU[K comparable]() Policy[K] {
+	return &LRU[K]{keys: newKeyList[K]()}
+}
+
+func (policy *LRU[K]) Insert(key K) {
+	policy.keys.pushFront(key)
+}
+
+func (policy *LRU[K]) Access(key K) {
+	policy.keys.moveToFront(key)
+}
+
+func (policy *LRU[K]) Victim() (K, bool) {
+	return policy.keys.back()
+}
+
+func (policy *LRU[K]) Remove(key K) {
+	policy.keys.remove(key)
+}
+
+func (policy *LRU[K]) Clear() {
+	policy.keys.clear()
+}
+
+// FIFO evicts the key that was inserted first, regardless of reads.
+type FIFO[K comparable] struct {
+	keys keyList[K]
+}
+
+func NewFIFO[K comparable]() Policy[K] {
+	return &FIFO[K]{keys: newKeyList[K]()}
+}
+
+func (policy *FIFO[K]) Insert(key K) {
+	policy.keys.pushFront(key)
+}
+
+func (policy *FIFO[K]) Access(K) {}
+
+func (policy *FIFO[K]) Victim() (K, bool) {
+	return policy.keys.back()
+}
+
+func (policy *FIFO[K]) Remove(key K) {
+	policy.keys.remove(key)
+}
+
+func (policy *FIFO[K]) Clear() {
+	policy.keys.clear()
+}
+
+// keyList is a list of keys with constant time lookup by key. It is the
+// building block of the list based policies.
+type keyList[K comparable] struct {
+	list     indexList[K]
+	elements map[K]int32
+}
+
+func newKeyList[K comparable]() keyList[K] {
+	return keyList[K]{
+		list:     newIndexList[K](0),
+		elements: make(map[K]int32),
+	}
+}
+
+func (keys *keyList[K]) pushFront(key K) {
+	keys.elements[key] = keys.list.pushFront(key)
+}
+
+func (keys *keyList[K]) moveToFront(key K) {
+	if i, ok := keys.elements[key]; ok {
+		keys.list.moveToFront(i)
+	}
+}
+
+func (keys *keyList[K]) back() (K, bool) {
+	if i := keys.list.back(); i != 0 {
+		return *keys.list.at(i), true
+	}
+	var zero K
+	return zero, false
+}
+
+func (keys *keyList[K]) contains(key K) bool {
+	_, ok := keys.elements[key]
+	return ok
+}
+
+func (keys *keyList[K]) remove(key K) bool {
+	if i, ok := keys.elements[key]; ok {
+		delete(keys.elements, key)
+		keys.list.remove(i)
+		return true
+	}
+	return false
+}
+
+func (keys *keyList[K]) len() int {
+	return keys.list.len()
+}
+
+func (keys *keyList[K]) clear() {
+	keys.list.clear()
+	keys.elements = make(map[K]int32)
+}
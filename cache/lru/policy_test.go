@@ -0,0 +1,100 @@
+package lru
+
+import (
+	"slices"
+	"testing"
+)
+
+// evictAll drains policy and returns its victims in order.
+func evictAll[K comparable](policy Policy[K]) []K {
+	var victims []K
+	for {
+		victim, ok := policy.Victim()
+		if !ok {
+			return victims
+		}
+		victims = append(victims, victim)
+		policy.Remove(victim)
+	}
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	policy := NewLRU[string]()
+	for _, key := range []string{"a", "b", "c", "d"} {
+		policy.Insert(key)
+	}
+	policy.Access("a")
+	policy.Access("c")
+	policy.Remove("d")
+
+	if victims := evictAll(policy); !slices.Equal(victims, []string{"b", "a", "c"}) {
+		t.Fatalf("victims = %v, want [b a c]", victims)
+	}
+}
+
+func TestFIFOIgnoresReads(t *testing.T) {
+	policy := NewFIFO[string]()
+	for _, key := range []string{"a", "b", "c"} {
+		policy.Insert(key)
+	}
+	policy.Access("a")
+
+	if victims := evictAll(policy); !slices.Equal(victims, []string{"a", "b", "c"}) {
+		t.Fatalf("victims = %v, want [a b c]", victims)
+	}
+}
+
+func TestRandomEvictsTrackedKeys(t *testing.T) {
+	policy := NewRandom[int]()
+	for key := range 100 {
+		policy.Insert(key)
+	}
+	// Removing from the middle moves the last key into the gap.
+	for key := 0; key < 100; key += 2 {
+		policy.Remove(key)
+	}
+
+	victims := evictAll(policy)
+	slices.Sort(victims)
+	for i, victim := range victims {
+		if victim != 2*i+1 {
+			t.Fatalf("victims = %v, want every odd key once", victims)
+		}
+	}
+	if len(victims) != 50 {
+		t.Fatalf("%d victims, want 50", len(victims))
+	}
+}
+
+func TestRandomVictimsVary(t *testing.T) {
+	policy := NewRandom[int]()
+	for key := range 10 {
+		policy.Insert(key)
+	}
+	seen := make(map[int]bool)
+	for range 1000 {
+		victim, _ := policy.Victim()
+		seen[victim] = true
+	}
+	if len(seen) != 10 {
+		t.Fatalf("1000 draws picked %d of 10 keys", len(seen))
+	}
+}
+
+func TestPoliciesClear(t *testing.T) {
+	for name, newPolicy := range map[string]func() Policy[int]{
+		"lru": NewLRU[int], "fifo": NewFIFO[int], "lfu": NewLFU[int], "random": NewRandom[int],
+	} {
+		policy := newPolicy()
+		policy.Insert(1)
+		policy.Insert(2)
+		policy.Clear()
+		if victim, ok := policy.Victim(); ok {
+			t.Fatalf("%s: Victim() = %d after Clear", name, victim)
+		}
+		policy.Insert(3)
+		if victim, _ := policy.Victim(); victim != 3 {
+			t.Fatalf("%s: Victim() = %d, want 3", name, victim)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package lru
+
+import "errors"
+
+// ErrNoRoom is returned by Put when the entry only fits by evicting entries
+// of a higher priority.
+var ErrNoRoom = errors.New("lru: no room without evicting higher priority entries")
+
+// Priority is the eviction class of an entry. Each priority has its own
+// instance of the eviction policy, and the cache evicts from the lowest
+// priority that has entries left. A put never evicts entries of a higher
+// priority than its own, so low priority writes such as prefetches don't
+// push out entries that were stored for a reason.
+type Priority int8
+
+const (
+	Low Priority = iota - 1
+	Normal
+	High
+)
+
+func (priority Priority) String() string {
+	switch priority {
+	case Low:
+		return "low"
+	case Normal:
+		return "normal"
+	case High:
+		return "high"
+	}
+	return "unknown"
+}
+
+// policyOf returns the policy that tracks entries of the given priority.
+func (cache *Cache[K, V]) policyOf(priority Priority) Policy[K] {
+	return cache.policies[priority-Low]
+}
+
+// track hands an entry that is not pinned to the policy of its priority,
+// and untrack takes it back.
+func (cache *Cache[K, V]) track(pair *KeyPair[K, V]) {
+	cache.policyOf(pair.priority).Insert(pair.key)
+	cache.tally(pair.priority, 1, pair.size)
+	cache.weigh(pair)
+}
+
+func (cache *Cache[K, V]) untrack(pair *KeyPair[K, V]) {
+	cache.policyOf(pair.priority).Remove(pair.key)
+	cache.tally(pair.priority, -1, -pair.size)
+}
+
+// tally adds entries and bytes to the counts of a priority, in the cache and
+// in its budget.
+func (cache *Cache[K, V]) tally(priority Priority, entries int, bytes int64) {
+	cache.tracked[priority-Low] += entries
+	cache.trackedBytes[priority-Low] += bytes
+	cache.budget.tracked[priority-Low].Add(int64(entries))
+	cache.budget.trackedBytes[priority-Low].Add(bytes)
+}
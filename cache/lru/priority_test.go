@@ -0,0 +1,103 @@
+package lru
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// newSizedCache returns a cache whose entries take their value in bytes,
+// overhead included.
+func newSizedCache(maxBytes int64) Cache[string, int64] {
+	overhead := entryOverhead[string, int64]()
+	return New(0,
+		WithMaxBytes[string, int64](maxBytes),
+		WithSizer(func(_ string, value int64) int64 { return value - overhead }))
+}
+
+func TestPriorityEvictsLowestFirst(t *testing.T) {
+	cache := New[string, int](3)
+	cache.PutWithOptions("high", 0, PutOptions{Priority: High})
+	cache.PutWithOptions("normal", 0, PutOptions{})
+	cache.PutWithOptions("low", 0, PutOptions{Priority: Low})
+	cache.Get("low")
+
+	cache.Put("new", 0)
+	if cache.Contains("low") || !cache.Contains("normal") || !cache.Contains("high") {
+		t.Fatalf("Keys() = %v, want low evicted first", cache.Keys())
+	}
+}
+
+func TestPriorityNoRoomEvictsNothing(t *testing.T) {
+	cache := newSizedCache(40)
+	cache.PutWithOptions("h1", 10, PutOptions{Priority: High})
+	cache.PutWithOptions("h2", 10, PutOptions{Priority: High})
+	cache.PutWithOptions("n1", 10, PutOptions{})
+	cache.PutWithOptions("l1", 10, PutOptions{Priority: Low})
+
+	if _, err := cache.PutWithOptions("n2", 25, PutOptions{}); !errors.Is(err, ErrNoRoom) {
+		t.Fatalf("PutWithOptions = %v, want ErrNoRoom", err)
+	}
+	keys := cache.Keys()
+	slices.Sort(keys)
+	if want := []string{"h1", "h2", "l1", "n1"}; !slices.Equal(keys, want) {
+		t.Fatalf("Keys() = %v after ErrNoRoom, want %v", keys, want)
+	}
+
+	if _, err := cache.PutWithOptions("n2", 15, PutOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if cache.Contains("l1") || cache.Contains("n1") || !cache.Contains("h1") || !cache.Contains("h2") {
+		t.Fatalf("Keys() = %v, want l1 and n1 evicted for n2", cache.Keys())
+	}
+}
+
+func TestPriorityOverwriteCountsOwnSlot(t *testing.T) {
+	cache := newSizedCache(40)
+	cache.PutWithOptions("h1", 10, PutOptions{Priority: High})
+	cache.PutWithOptions("h2", 10, PutOptions{Priority: High})
+	cache.PutWithOptions("n1", 10, PutOptions{})
+
+	// Growing n1 fits in its own bytes plus the free ones.
+	if _, err := cache.PutWithOptions("n1", 20, PutOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.PutWithOptions("n1", 30, PutOptions{}); !errors.Is(err, ErrNoRoom) {
+		t.Fatalf("PutWithOptions = %v, want ErrNoRoom", err)
+	}
+	if !cache.Contains("n1") {
+		t.Fatal("n1 was evicted by a put that failed")
+	}
+}
+
+func TestPriorityPinnedMayEvictAnything(t *testing.T) {
+	cache := New[string, int](2)
+	cache.PutWithOptions("high", 0, PutOptions{Priority: High})
+	cache.PutWithOptions("low", 0, PutOptions{Priority: Low})
+	if _, err := cache.PutWithOptions("pinned", 0, PutOptions{Pinned: true, Priority: Low}); err != nil {
+		t.Fatal(err)
+	}
+	if cache.Contains("low") || !cache.Contains("high") {
+		t.Fatalf("Keys() = %v, want low evicted", cache.Keys())
+	}
+}
+
+func TestShardedPriorityCountsTiersOfAllShards(t *testing.T) {
+	cache := NewSharded[string, int](10, 2)
+	keys := keysByShard(cache, 6)
+	for _, key := range keys[0][:5] {
+		cache.PutWithOptions(key, 0, PutOptions{Priority: High})
+	}
+	for _, key := range keys[1][:5] {
+		cache.PutWithOptions(key, 0, PutOptions{})
+	}
+
+	// The first shard has nothing to evict at normal priority, but the
+	// second one has.
+	if _, err := cache.PutWithOptions(keys[0][5], 0, PutOptions{}); err != nil {
+		t.Fatalf("PutWithOptions = %v, want the put to fit", err)
+	}
+	if !cache.Contains(keys[0][5]) || cache.Contains(keys[1][0]) || cache.Len() != 10 {
+		t.Fatalf("Keys() = %v, want the put in place of the oldest normal entry", cache.Keys())
+	}
+}
@@ -0,0 +1,357 @@
+package lru
+
+import (
+	"container/heap"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// WithPrefixIndex keeps the keys in a radix tree next to the hash map, so
+// KeysWithPrefix, CountPrefix and RangePrefix find the keys under a prefix
+// without scanning all of them. It costs a tree node or two per key.
+func WithPrefixIndex[K ~string, V any]() Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.prefixes = &radixTree[K]{}
+		cache.keyString = func(key K) string { return string(key) }
+	}
+}
+
+// KeysWithPrefix returns the keys that start with prefix and have not
+// expired, in lexicographic order. It returns nil unless the cache was built
+// WithPrefixIndex.
+func (cache *Cache[K, V]) KeysWithPrefix(prefix K) []K {
+	var keys []K
+	cache.RangePrefix(prefix, func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// CountPrefix returns the number of keys that start with prefix, including
+// expired ones that have not been dropped yet, like Len. It takes time in
+// the length of the prefix, not in the number of keys.
+func (cache *Cache[K, V]) CountPrefix(prefix K) int {
+	if cache.prefixes == nil {
+		return 0
+	}
+	if n, _ := cache.prefixes.find(cache.keyString(prefix)); n != nil {
+		return n.size
+	}
+	return 0
+}
+
+// RangePrefix calls fn for the entries whose key starts with prefix and
+// that have not expired, in lexicographic order of the keys, until fn
+// returns false. Reading the entries doesn't count as a use. fn must not
+// modify the cache.
+func (cache *Cache[K, V]) RangePrefix(prefix K, fn func(key K, value V) bool) {
+	cache.rangePrefix(prefix, "", true, fn)
+}
+
+// rangePrefix is RangePrefix over the keys greater than after, or over all
+// of them if first is set.
+func (cache *Cache[K, V]) rangePrefix(prefix K, after string, first bool, fn func(key K, value V) bool) {
+	if cache.prefixes == nil {
+		return
+	}
+	n, s := cache.prefixes.find(cache.keyString(prefix))
+	if n == nil {
+		return
+	}
+	now := cache.clock.Now()
+	visit := func(key K) bool {
+		pair := cache.entries.at(cache.elements[key])
+		return pair.expired(now) || fn(key, pair.value)
+	}
+	if first {
+		n.walk(visit)
+	} else {
+		n.walkAfter(s, after, visit)
+	}
+}
+
+// KeysWithPrefix returns the keys of all shards that start with prefix, in
+// lexicographic order.
+func (sharded *Sharded[K, V]) KeysWithPrefix(prefix K) []K {
+	var keys []K
+	sharded.RangePrefix(prefix, func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func (sharded *Sharded[K, V]) CountPrefix(prefix K) int {
+	total := 0
+	for _, s := range sharded.shards {
+		s.Lock()
+		total += s.cache.CountPrefix(prefix)
+		s.Unlock()
+	}
+	return total
+}
+
+// rangeBatch is the number of entries RangePrefix copies out of a shard at
+// a time.
+const rangeBatch = 64
+
+// RangePrefix is Cache.RangePrefix over all shards. It merges the ordered
+// walks of the shards, copying the entries out of each shard a batch at a
+// time, and stops reading the shards as soon as fn returns false. fn runs
+// without holding any lock, so it may use the cache; entries put or removed
+// while RangePrefix runs may or may not be seen.
+func (sharded *Sharded[K, V]) RangePrefix(prefix K, fn func(key K, value V) bool) {
+	var cursors prefixCursors[K, V]
+	for _, s := range sharded.shards {
+		cursor := &prefixCursor[K, V]{shard: s}
+		if cursor.fill(prefix) {
+			cursors = append(cursors, cursor)
+		}
+	}
+	heap.Init(&cursors)
+
+	for len(cursors) > 0 {
+		cursor := cursors[0]
+		pair := cursor.pairs[cursor.next]
+		if !fn(pair.key, pair.value) {
+			return
+		}
+		cursor.next++
+		if cursor.next == len(cursor.pairs) && !cursor.fill(prefix) {
+			heap.Pop(&cursors)
+			continue
+		}
+		heap.Fix(&cursors, 0)
+	}
+}
+
+// prefixCursor holds the next batch of entries of one shard for
+// Sharded.RangePrefix.
+type prefixCursor[K comparable, V any] struct {
+	shard *shard[K, V]
+	pairs []KeyPair[K, V]
+	keys  []string
+	next  int
+	// after is the last key read so far, and done is set once the walk of
+	// the shard has come to its end.
+	after         string
+	started, done bool
+}
+
+// fill reads the batch after the last key read and reports whether it holds
+// any entries.
+func (cursor *prefixCursor[K, V]) fill(prefix K) bool {
+	if cursor.done {
+		return false
+	}
+	cursor.pairs, cursor.keys, cursor.next = cursor.pairs[:0], cursor.keys[:0], 0
+
+	s := cursor.shard
+	s.Lock()
+	s.cache.rangePrefix(prefix, cursor.after, !cursor.started, func(key K, value V) bool {
+		cursor.pairs = append(cursor.pairs, KeyPair[K, V]{key: key, value: value})
+		cursor.keys = append(cursor.keys, s.cache.keyString(key))
+		return len(cursor.pairs) < rangeBatch
+	})
+	s.Unlock()
+
+	cursor.started = true
+	cursor.done = len(cursor.pairs) < rangeBatch
+	if len(cursor.pairs) == 0 {
+		return false
+	}
+	cursor.after = cursor.keys[len(cursor.keys)-1]
+	return true
+}
+
+// prefixCursors is a min-heap of cursors ordered by their next key.
+type prefixCursors[K comparable, V any] []*prefixCursor[K, V]
+
+func (h prefixCursors[K, V]) Len() int {
+	return len(h)
+}
+
+func (h prefixCursors[K, V]) Less(i, j int) bool {
+	return h[i].keys[h[i].next] < h[j].keys[h[j].next]
+}
+
+func (h prefixCursors[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *prefixCursors[K, V]) Push(x any) {
+	*h = append(*h, x.(*prefixCursor[K, V]))
+}
+
+func (h *prefixCursors[K, V]) Pop() any {
+	old := *h
+	cursor := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return cursor
+}
+
+// radixTree is a compressed trie of keys. Each edge is labelled with a
+// non-empty string, siblings are sorted by the first byte of their label,
+// and a node with a single child that holds no key is merged into that
+// child. Every node counts the keys below it, so counting the keys under a
+// prefix only walks down the prefix.
+type radixTree[K any] struct {
+	root radixNode[K]
+}
+
+type radixNode[K any] struct {
+	label    string
+	children []*radixNode[K]
+	key      K
+	leaf     bool
+	size     int
+}
+
+func (tree *radixTree[K]) insert(s string, key K) {
+	tree.root.insert(s, key)
+}
+
+func (tree *radixTree[K]) remove(s string) {
+	tree.root.remove(s)
+}
+
+func (tree *radixTree[K]) clear() {
+	tree.root = radixNode[K]{}
+}
+
+// find returns the node whose subtree holds exactly the keys starting with
+// prefix, or nil if there are none, and the key that node would hold.
+func (tree *radixTree[K]) find(prefix string) (*radixNode[K], string) {
+	n, path := &tree.root, ""
+	for prefix != "" {
+		i, ok := n.child(prefix[0])
+		if !ok {
+			return nil, ""
+		}
+		child := n.children[i]
+		if len(prefix) <= len(child.label) {
+			if strings.HasPrefix(child.label, prefix) {
+				return child, path + child.label
+			}
+			return nil, ""
+		}
+		if !strings.HasPrefix(prefix, child.label) {
+			return nil, ""
+		}
+		path += child.label
+		prefix = prefix[len(child.label):]
+		n = child
+	}
+	return n, path
+}
+
+// child returns the index of the child whose label starts with b, or where
+// it would be inserted.
+func (n *radixNode[K]) child(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].label[0] >= b
+	})
+	return i, i < len(n.children) && n.children[i].label[0] == b
+}
+
+func (n *radixNode[K]) insert(s string, key K) bool {
+	if s == "" {
+		n.key = key
+		if n.leaf {
+			return false
+		}
+		n.leaf = true
+		n.size++
+		return true
+	}
+
+	i, ok := n.child(s[0])
+	if !ok {
+		n.children = slices.Insert(n.children, i, &radixNode[K]{label: s, key: key, leaf: true, size: 1})
+		n.size++
+		return true
+	}
+
+	child := n.children[i]
+	common := 0
+	for common < len(child.label) && common < len(s) && child.label[common] == s[common] {
+		common++
+	}
+	if common < len(child.label) {
+		split := &radixNode[K]{label: child.label[:common], children: []*radixNode[K]{child}, size: child.size}
+		child.label = child.label[common:]
+		n.children[i] = split
+		child = split
+	}
+	if child.insert(s[common:], key) {
+		n.size++
+		return true
+	}
+	return false
+}
+
+func (n *radixNode[K]) remove(s string) bool {
+	if s == "" {
+		if !n.leaf {
+			return false
+		}
+		var zero K
+		n.key, n.leaf = zero, false
+		n.size--
+		return true
+	}
+
+	i, ok := n.child(s[0])
+	if !ok {
+		return false
+	}
+	child := n.children[i]
+	if !strings.HasPrefix(s, child.label) || !child.remove(s[len(child.label):]) {
+		return false
+	}
+	n.size--
+
+	switch {
+	case child.size == 0:
+		n.children = slices.Delete(n.children, i, i+1)
+	case !child.leaf && len(child.children) == 1:
+		grandchild := child.children[0]
+		grandchild.label = child.label + grandchild.label
+		n.children[i] = grandchild
+	}
+	return true
+}
+
+// walk calls fn for the keys below n in lexicographic order until it
+// returns false.
+func (n *radixNode[K]) walk(fn func(key K) bool) bool {
+	if n.leaf && !fn(n.key) {
+		return false
+	}
+	for _, child := range n.children {
+		if !child.walk(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// walkAfter is walk over the keys greater than after, where s is the key n
+// would hold. It only goes down the path to after and skips the subtrees
+// before it.
+func (n *radixNode[K]) walkAfter(s, after string, fn func(key K) bool) bool {
+	if !strings.HasPrefix(after, s) {
+		// Every key below n orders against after the way s does.
+		return s < after || n.walk(fn)
+	}
+	// n holds after or a prefix of it, so its own key is not greater.
+	for _, child := range n.children {
+		if !child.walkAfter(s+child.label, after, fn) {
+			return false
+		}
+	}
+	return true
+}
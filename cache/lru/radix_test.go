@@ -0,0 +1,145 @@
+package lru
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// radixKeys returns the keys below the node for prefix, in walk order.
+func radixKeys(tree *radixTree[string], prefix string) []string {
+	var keys []string
+	if n, _ := tree.find(prefix); n != nil {
+		n.walk(func(key string) bool {
+			keys = append(keys, key)
+			return true
+		})
+	}
+	return keys
+}
+
+func TestRadixInsertSplitsEdges(t *testing.T) {
+	tree := &radixTree[string]{}
+	for _, key := range []string{"team", "tea", "ten", "test", "te", "toast"} {
+		tree.insert(key, key)
+	}
+	tree.insert("tea", "tea")
+
+	if want := []string{"te", "tea", "team", "ten", "test", "toast"}; !slices.Equal(radixKeys(tree, ""), want) {
+		t.Fatalf("keys = %v, want %v", radixKeys(tree, ""), want)
+	}
+	if size := tree.root.size; size != 6 {
+		t.Fatalf("root size = %d, want 6", size)
+	}
+	n, s := tree.find("te")
+	if n == nil || s != "te" || n.size != 5 || !n.leaf {
+		t.Fatalf("find(te) = %+v, %q", n, s)
+	}
+	// The prefix ends inside the label of an edge.
+	if n, s := tree.find("toa"); n == nil || s != "toast" || n.size != 1 {
+		t.Fatalf("find(toa) = %+v, %q", n, s)
+	}
+	if n, _ := tree.find("tx"); n != nil {
+		t.Fatal("find(tx) found a node")
+	}
+}
+
+func TestRadixRemoveMergesEdges(t *testing.T) {
+	tree := &radixTree[string]{}
+	for _, key := range []string{"team", "tea", "ten"} {
+		tree.insert(key, key)
+	}
+	tree.remove("te")
+	tree.remove("tea")
+	tree.remove("ten")
+
+	// Only team is left, and the nodes on its path are merged into one edge.
+	if len(tree.root.children) != 1 || tree.root.children[0].label != "team" {
+		t.Fatalf("root children = %+v, want a single edge team", tree.root.children)
+	}
+	if size := tree.root.size; size != 1 {
+		t.Fatalf("root size = %d, want 1", size)
+	}
+	tree.remove("team")
+	if len(tree.root.children) != 0 || tree.root.size != 0 {
+		t.Fatalf("root = %+v after removing every key", tree.root)
+	}
+}
+
+func TestRadixWalkAfter(t *testing.T) {
+	tree := &radixTree[string]{}
+	keys := []string{"a", "ab", "abc", "abd", "b", "ba"}
+	for _, key := range keys {
+		tree.insert(key, key)
+	}
+	for i, after := range keys {
+		var got []string
+		n, s := tree.find("")
+		n.walkAfter(s, after, func(key string) bool {
+			got = append(got, key)
+			return true
+		})
+		if want := keys[i+1:]; !slices.Equal(got, want) {
+			t.Fatalf("walkAfter(%s) = %v, want %v", after, got, want)
+		}
+	}
+}
+
+func TestRangePrefixSkipsExpired(t *testing.T) {
+	clock := newFakeClock()
+	cache := New(0, WithPrefixIndex[string, int](), WithClock[string, int](clock))
+	cache.Put("notes:1", 1)
+	cache.PutWithOptions("notes:2", 2, PutOptions{TTL: time.Minute})
+	cache.Put("tasks:1", 3)
+	clock.advance(2 * time.Minute)
+
+	if keys := cache.KeysWithPrefix("notes:"); !slices.Equal(keys, []string{"notes:1"}) {
+		t.Fatalf("KeysWithPrefix = %v, want [notes:1]", keys)
+	}
+	// The expired key has not been dropped yet.
+	if n := cache.CountPrefix("notes:"); n != 2 {
+		t.Fatalf("CountPrefix = %d, want 2", n)
+	}
+}
+
+func TestShardedRangePrefixMergesInOrder(t *testing.T) {
+	cache := NewSharded[string, int](0, 8, WithPrefixIndex[string, int]())
+	var want []string
+	for i := range 1000 {
+		key := "notes:" + strconv.Itoa(i)
+		cache.Put(key, i)
+		want = append(want, key)
+		cache.Put("tasks:"+strconv.Itoa(i), i)
+	}
+	slices.Sort(want)
+
+	if keys := cache.KeysWithPrefix("notes:"); !slices.Equal(keys, want) {
+		t.Fatalf("KeysWithPrefix returned %d keys, want %d in order", len(keys), len(want))
+	}
+
+	var seen []string
+	cache.RangePrefix("notes:", func(key string, _ int) bool {
+		seen = append(seen, key)
+		return len(seen) < 3
+	})
+	if !slices.Equal(seen, want[:3]) {
+		t.Fatalf("RangePrefix saw %v before stopping, want %v", seen, want[:3])
+	}
+}
+
+func TestShardedRangePrefixMayUseCache(t *testing.T) {
+	cache := NewSharded[string, int](0, 4, WithPrefixIndex[string, int]())
+	for i := range 200 {
+		cache.Put("notes:"+strconv.Itoa(i), i)
+	}
+	count := 0
+	cache.RangePrefix("notes:", func(key string, _ int) bool {
+		cache.Remove(key)
+		count++
+		return true
+	})
+	if count != 200 || cache.Len() != 0 {
+		t.Fatalf("RangePrefix saw %d keys, %d left", count, cache.Len())
+	}
+}
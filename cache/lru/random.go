@@ -0,0 +1,47 @@
+package lru
+
+import "math/rand/v2"
+
+// Random evicts a uniformly random key. It keeps no ordering at all, which
+// makes it a useful baseline when comparing hit ratios.
+type Random[K comparable] struct {
+	keys  []K
+	index map[K]int
+}
+
+func NewRandom[K comparable]() Policy[K] {
+	return &Random[K]{index: make(map[K]int)}
+}
+
+func (policy *Random[K]) Insert(key K) {
+	policy.index[key] = len(policy.keys)
+	policy.keys = append(policy.keys, key)
+}
+
+func (policy *Random[K]) Access(K) {}
+
+func (policy *Random[K]) Victim() (K, bool) {
+	if len(policy.keys) == 0 {
+		var zero K
+		return zero, false
+	}
+	return policy.keys[rand.IntN(len(policy.keys))], true
+}
+
+func (policy *Random[K]) Remove(key K) {
+	i, ok := policy.index[key]
+	if !ok {
+		return
+	}
+
+	last := len(policy.keys) - 1
+	policy.keys[i] = policy.keys[last]
+	policy.index[policy.keys[i]] = i
+	policy.keys = policy.keys[:last]
+	delete(policy.index, key)
+}
+
+func (policy *Random[K]) Clear() {
+	policy.keys = nil
+	policy.index = make(map[K]int)
+}
@@ -0,0 +1,318 @@
+package lru
+
+import (
+	"hash/maphash"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Sharded is a cache that is safe for concurrent use. Keys are spread over
+// independently locked shards, each one a plain Cache with its own recency
+// list, so handlers touching different keys rarely contend.
+//
+// The capacity and byte budget are shared by all shards, so the cache holds
+// capacity entries or maxBytes bytes before it evicts anything, however the
+// keys are spread. Each shard runs its own instance of the eviction policy
+// over the keys it holds, and a put that finds the cache full evicts the
+// victim of its own shard. If its shard has nothing to evict, the put
+// evicts from the other shards once its shard is unlocked, so the bounds
+// hold again by the time it returns. Only while puts are in flight, or when
+// nothing but pinned entries are left, can the cache be over its bounds.
+type Sharded[K comparable, V any] struct {
+	shards []*shard[K, V]
+	seed   maphash.Seed
+	// tokens hands out lease tokens. It starts at a random number so tokens
+	// from before a restart are not valid afterwards.
+	tokens atomic.Uint64
+}
+
+type shard[K comparable, V any] struct {
+	sync.Mutex
+	cache   Cache[K, V]
+	sharded *Sharded[K, V]
+}
+
+func NewSharded[K comparable, V any](capacity int, shards int, options ...Option[K, V]) *Sharded[K, V] {
+	if capacity > 0 && shards > capacity {
+		shards = capacity
+	}
+	if shards < 1 {
+		shards = 1
+	}
+
+	sharded := &Sharded[K, V]{
+		shards: make([]*shard[K, V], shards),
+		seed:   maphash.MakeSeed(),
+	}
+	sharded.tokens.Store(rand.Uint64())
+	for i := range sharded.shards {
+		cache := New(int(split(int64(capacity), shards, i)), options...)
+		cache.deferred = true
+		if i == 0 {
+			cache.budget.capacity.Store(int64(capacity))
+		} else {
+			cache.budget = sharded.shards[0].cache.budget
+			cache.pins = sharded.shards[0].cache.pins
+		}
+		sharded.shards[i] = &shard[K, V]{cache: cache, sharded: sharded}
+	}
+	return sharded
+}
+
+// split returns the part of total that the i-th of n shards gets.
+func split(total int64, n int, i int) int64 {
+	part := total / int64(n)
+	if int64(i) < total%int64(n) {
+		part++
+	}
+	return part
+}
+
+// unlock releases the shard, evicts from the other shards if a put left the
+// cache over its bounds, and then runs the eviction callbacks for the
+// entries dropped while it was held.
+func (s *shard[K, V]) unlock() {
+	s.release().run()
+}
+
+// afterUnlock is the work a shard leaves for after its lock is released.
+type afterUnlock[K comparable, V any] struct {
+	shard     *shard[K, V]
+	evictions []eviction[K, V]
+	callbacks []EvictionCallback[K, V]
+	spilled   bool
+	spill     Priority
+}
+
+// release unlocks the shard and returns the work left for afterwards.
+func (s *shard[K, V]) release() afterUnlock[K, V] {
+	after := afterUnlock[K, V]{
+		shard:     s,
+		evictions: s.cache.pending,
+		callbacks: s.cache.callbacks,
+		spilled:   s.cache.spilled,
+		spill:     s.cache.spill,
+	}
+	s.cache.pending, s.cache.spilled = nil, false
+	s.Unlock()
+	return after
+}
+
+func (after afterUnlock[K, V]) run() {
+	if after.spilled {
+		after.shard.sharded.rebalance(after.spill, after.shard)
+	}
+	notify(after.callbacks, after.evictions)
+}
+
+// OnEvict registers the callback with every shard.
+func (sharded *Sharded[K, V]) OnEvict(callback EvictionCallback[K, V]) {
+	for _, s := range sharded.shards {
+		s.Lock()
+		s.cache.OnEvict(callback)
+		s.Unlock()
+	}
+}
+
+func (sharded *Sharded[K, V]) shardFor(key K) *shard[K, V] {
+	return sharded.shards[sharded.shardIndex(key)]
+}
+
+func (sharded *Sharded[K, V]) shardIndex(key K) int {
+	hash := maphash.Comparable(sharded.seed, key)
+	return int(hash % uint64(len(sharded.shards)))
+}
+
+func (sharded *Sharded[K, V]) Get(key K) (V, bool) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	return s.cache.Get(key)
+}
+
+func (sharded *Sharded[K, V]) Peek(key K) (V, bool) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.Unlock()
+	return s.cache.Peek(key)
+}
+
+func (sharded *Sharded[K, V]) Contains(key K) bool {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.Unlock()
+	return s.cache.Contains(key)
+}
+
+func (sharded *Sharded[K, V]) Put(key K, value V) error {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	return s.cache.Put(key, value)
+}
+
+func (sharded *Sharded[K, V]) PutWithTTL(key K, value V, ttl time.Duration) error {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	return s.cache.PutWithTTL(key, value, ttl)
+}
+
+func (sharded *Sharded[K, V]) PutWithOptions(key K, value V, options PutOptions) (uint64, error) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	return s.cache.PutWithOptions(key, value, options)
+}
+
+func (sharded *Sharded[K, V]) Len() int {
+	total := 0
+	for _, s := range sharded.shards {
+		s.Lock()
+		total += s.cache.Len()
+		s.Unlock()
+	}
+	return total
+}
+
+// Keys returns the keys of all shards from the most to the least recently
+// used. Shards are read one after the other, so under concurrent writes the
+// result is not a snapshot of a single instant.
+func (sharded *Sharded[K, V]) Keys() []K {
+	var pairs []KeyPair[K, V]
+	for _, s := range sharded.shards {
+		s.Lock()
+		pairs = append(pairs, s.cache.pairs()...)
+		s.Unlock()
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].accessed.After(pairs[j].accessed)
+	})
+	keys := make([]K, len(pairs))
+	for i, pair := range pairs {
+		keys[i] = pair.key
+	}
+	return keys
+}
+
+// Resize changes the capacity and evicts entries until the cache fits in
+// it, like a put that finds the cache full. It returns how many entries were
+// evicted.
+func (sharded *Sharded[K, V]) Resize(capacity int) (evicted int) {
+	sharded.shards[0].cache.budget.capacity.Store(int64(capacity))
+	return sharded.rebalance(High, nil)
+}
+
+// rebalance evicts entries of at most the given priority from any shard
+// until the cache is back within its bounds. It evicts from the lowest
+// priority first, and within a priority one victim per shard in turn, so
+// the shards shrink evenly. It locks one shard at a time. A put leaves out
+// its own shard, where it found nothing to evict but the entry it stored.
+func (sharded *Sharded[K, V]) rebalance(limit Priority, skip *shard[K, V]) (evicted int) {
+	budget := sharded.shards[0].cache.budget
+	for priority := Low; priority <= limit && budget.over(); priority++ {
+		for progress := true; progress && budget.over(); {
+			progress = false
+			for _, s := range sharded.shards {
+				if s == skip {
+					continue
+				}
+				s.Lock()
+				if budget.over() && s.cache.evictFrom(priority) {
+					evicted++
+					progress = true
+				}
+				s.unlock()
+			}
+		}
+	}
+	return evicted
+}
+
+// Stats adds up the statistics of all shards.
+func (sharded *Sharded[K, V]) Stats() Stats {
+	var total Stats
+	for _, s := range sharded.shards {
+		s.Lock()
+		stats := s.cache.Stats()
+		s.Unlock()
+
+		total.Hits += stats.Hits
+		total.Misses += stats.Misses
+		total.Evictions += stats.Evictions
+		total.Entries += stats.Entries
+		total.Bytes += stats.Bytes
+		total.Pinned += stats.Pinned
+		for segment, keys := range stats.Segments {
+			if total.Segments == nil {
+				total.Segments = make(map[string]int)
+			}
+			total.Segments[segment] += keys
+		}
+	}
+	return total
+}
+
+func (sharded *Sharded[K, V]) Clear() {
+	for _, s := range sharded.shards {
+		s.Lock()
+		s.cache.Clear()
+		s.unlock()
+	}
+}
+
+func (sharded *Sharded[K, V]) Remove(key K) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+	s.cache.Remove(key)
+}
+
+const (
+	sweepSamples = 20
+	// sweepRounds bounds how long one tick may hold a shard's lock.
+	sweepRounds = 16
+)
+
+// StartSweeper actively expires keys in the background, the way Redis does:
+// every interval each shard samples some keys that have a TTL and removes
+// the expired ones, repeating while more than a quarter of the sample was
+// expired. Keys that are never read again are freed without waiting for
+// capacity eviction. Calling the returned function stops the sweeper.
+func (sharded *Sharded[K, V]) StartSweeper(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				for _, s := range sharded.shards {
+					s.sweep()
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+func (s *shard[K, V]) sweep() {
+	s.Lock()
+	defer s.unlock()
+	for round := 0; round < sweepRounds; round++ {
+		checked, removed := s.cache.sweep(sweepSamples)
+		if checked == 0 || removed*4 <= checked {
+			return
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package lru
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestShardedHoldsFullCapacity(t *testing.T) {
+	cache := NewSharded[string, string](100, 16)
+	for i := range 100 {
+		if err := cache.Put("notes:"+strconv.Itoa(i), "note"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := cache.Len(); n != 100 {
+		t.Fatalf("Len() = %d after 100 puts into a cache of 100, want 100", n)
+	}
+	if evictions := cache.Stats().Evictions; evictions != 0 {
+		t.Fatalf("%d evictions before the cache was full", evictions)
+	}
+
+	cache.Put("notes:100", "note")
+	if n := cache.Len(); n != 100 {
+		t.Fatalf("Len() = %d after going over capacity, want 100", n)
+	}
+}
+
+func TestShardedHoldsFullByteBudget(t *testing.T) {
+	const entries = 64
+	size := entryOverhead[string, string]() + int64(len("notes:00")+len("note"))
+	cache := NewSharded[string, string](0, 16, WithMaxBytes[string, string](entries*size))
+	for i := range entries {
+		cache.Put("notes:"+strconv.Itoa(10+i), "note")
+	}
+	if stats := cache.Stats(); stats.Entries != entries || stats.Evictions != 0 {
+		t.Fatalf("Stats() = %+v, want %d entries and no evictions", stats, entries)
+	}
+}
+
+func TestShardedPutIntoEmptyShardKeepsCapacity(t *testing.T) {
+	cache := NewSharded[string, int](4, 4)
+	keys := keysByShard(cache, 4)
+	for _, key := range keys[0] {
+		cache.Put(key, 0)
+	}
+	var evicted []string
+	cache.OnEvict(func(key string, _ int, _ EvictionReason) {
+		evicted = append(evicted, key)
+	})
+
+	cache.Put(keys[1][0], 0)
+	if n := cache.Len(); n != 4 {
+		t.Fatalf("Len() = %d after a put into an empty shard, want 4", n)
+	}
+	if len(evicted) != 1 || evicted[0] != keys[0][0] {
+		t.Fatalf("evicted %v, want the least recent key of the other shard", evicted)
+	}
+}
+
+func TestShardedResize(t *testing.T) {
+	cache := NewSharded[string, int](100, 4)
+	for _, shardKeys := range keysByShard(cache, 25) {
+		for _, key := range shardKeys {
+			cache.Put(key, 0)
+		}
+	}
+	if evicted := cache.Resize(40); evicted != 60 {
+		t.Fatalf("Resize(40) evicted %d entries, want 60", evicted)
+	}
+	for i, s := range cache.shards {
+		if n := s.cache.Len(); n != 10 {
+			t.Fatalf("shard %d holds %d entries after Resize(40), want 10", i, n)
+		}
+	}
+}
+
+// TestShardedConcurrent is meant to run with -race. It mixes every kind of
+// call on overlapping keys and then checks that the shared budget still
+// matches what the shards hold and that the cache is within its capacity.
+func TestShardedConcurrent(t *testing.T) {
+	const capacity = 256
+	cache := NewSharded[string, string](capacity, 8, WithPrefixIndex[string, string]())
+	var evicted atomic.Int64
+	cache.OnEvict(func(string, string, EvictionReason) {
+		evicted.Add(1)
+	})
+
+	var wg sync.WaitGroup
+	for worker := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 2000 {
+				key := "notes:" + strconv.Itoa((worker*7+i)%512)
+				switch i % 8 {
+				case 0:
+					cache.Remove(key)
+				case 1:
+					cache.MSet([]Entry[string, string]{{Key: key, Value: "a"}, {Key: key + ":b", Value: "b"}})
+				case 2:
+					cache.MGet([]string{key, key + ":b"})
+				case 3:
+					IncrBy(cache, key+":count", 1, PutOptions{})
+				case 4:
+					cache.KeysWithPrefix("notes:1")
+				default:
+					cache.Put(key, strconv.Itoa(i))
+					cache.Get(key)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	budget := cache.shards[0].cache.budget
+	var entries, bytes int64
+	for _, s := range cache.shards {
+		entries += int64(s.cache.Len())
+		bytes += s.cache.bytes
+	}
+	if budget.entries.Load() != entries || budget.bytes.Load() != bytes {
+		t.Fatalf("budget counts %d entries and %d bytes, shards hold %d and %d",
+			budget.entries.Load(), budget.bytes.Load(), entries, bytes)
+	}
+	if evicted.Load() == 0 {
+		t.Fatal("no eviction callbacks ran")
+	}
+	if entries > capacity {
+		t.Fatalf("%d entries in a cache of %d", entries, capacity)
+	}
+}
+
+func TestShardedConcurrentGetOrLoad(t *testing.T) {
+	cache := NewSharded[string, int](0, 4)
+	var loads sync.Map
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				key := strconv.Itoa(i)
+				value, err := cache.GetOrLoad(t.Context(), key, func(key string) (int, error) {
+					if _, loaded := loads.LoadOrStore(key, true); loaded {
+						t.Errorf("%s loaded twice", key)
+					}
+					return i, nil
+				}, PutOptions{})
+				if err != nil || value != i {
+					t.Errorf("GetOrLoad(%s) = %d, %v", key, value, err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
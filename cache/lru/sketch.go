@@ -0,0 +1,103 @@
+package lru
+
+// sketchDepth is the number of rows, and so of hash functions, of a
+// countMinSketch.
+const sketchDepth = 4
+
+// maxCount caps the counters at what would fit in four bits. Popularity
+// only has to be compared, not measured, so small counters are enough.
+const maxCount = 15
+
+// countMinSketch estimates how often keys were seen in a fixed amount of
+// memory. Estimates may be too high because of collisions but never too
+// low. Once sampleSize increments have been recorded every counter is
+// halved, so the sketch follows changes in popularity instead of favouring
+// keys that were hot long ago.
+type countMinSketch struct {
+	counters   []uint8
+	mask       uint64
+	additions  int
+	sampleSize int
+}
+
+func newCountMinSketch(width int) *countMinSketch {
+	size := 16
+	for size < width {
+		size <<= 1
+	}
+	return &countMinSketch{
+		counters:   make([]uint8, sketchDepth*size),
+		mask:       uint64(size - 1),
+		sampleSize: 10 * size,
+	}
+}
+
+func (sketch *countMinSketch) width() int {
+	return int(sketch.mask + 1)
+}
+
+func (sketch *countMinSketch) increment(hash uint64) {
+	added := false
+	for row := 0; row < sketchDepth; row++ {
+		i := sketch.index(hash, row)
+		if sketch.counters[i] < maxCount {
+			sketch.counters[i]++
+			added = true
+		}
+	}
+
+	if added {
+		sketch.additions++
+		if sketch.additions >= sketch.sampleSize {
+			sketch.age()
+		}
+	}
+}
+
+func (sketch *countMinSketch) estimate(hash uint64) uint8 {
+	estimate := uint8(maxCount)
+	for row := 0; row < sketchDepth; row++ {
+		if count := sketch.counters[sketch.index(hash, row)]; count < estimate {
+			estimate = count
+		}
+	}
+	return estimate
+}
+
+// index derives the counter of a row by double hashing, so one hash of the
+// key serves every row. The hash must be well mixed, as only its low bits
+// pick the counter.
+func (sketch *countMinSketch) index(hash uint64, row int) int {
+	h := hash + uint64(row)*(hash>>32|hash<<32)
+	return row*sketch.width() + int(h&sketch.mask)
+}
+
+// double doubles the width of the sketch and keeps the counts. A hash picks
+// counter i or i+width of a row in the wider sketch, where i is the counter
+// it picked before, so both start with the old count.
+func (sketch *countMinSketch) double() {
+	width := sketch.width()
+	counters := make([]uint8, 2*len(sketch.counters))
+	for row := 0; row < sketchDepth; row++ {
+		old := sketch.counters[row*width : (row+1)*width]
+		copy(counters[2*row*width:], old)
+		copy(counters[(2*row+1)*width:], old)
+	}
+	sketch.counters = counters
+	sketch.mask = sketch.mask<<1 | 1
+	sketch.sampleSize *= 2
+}
+
+func (sketch *countMinSketch) age() {
+	for i := range sketch.counters {
+		sketch.counters[i] >>= 1
+	}
+	sketch.additions /= 2
+}
+
+func (sketch *countMinSketch) clear() {
+	for i := range sketch.counters {
+		sketch.counters[i] = 0
+	}
+	sketch.additions = 0
+}
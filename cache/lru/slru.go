@@ -0,0 +1,74 @@
+package lru
+
+// SLRU is a segmented LRU in the style of 2Q. New keys enter a probationary
+// FIFO, where reads don't reorder them. A key is promoted to the protected
+// LRU segment on its second use, the first read after it was inserted. When
+// the protected segment outgrows its share, its least recently used key is
+// demoted back to the head of probation rather than evicted. Victims are
+// taken from the tail of probation, so keys used only once leave first.
+type SLRU[K comparable] struct {
+	probation      keyList[K]
+	protected      keyList[K]
+	protectedRatio float64
+}
+
+// NewSLRU returns a constructor for SLRU policies that keep up to
+// protectedRatio of their keys in the protected segment. The ratio is
+// clamped to [0, 1].
+func NewSLRU[K comparable](protectedRatio float64) func() Policy[K] {
+	if !(protectedRatio > 0) {
+		protectedRatio = 0
+	}
+	protectedRatio = min(protectedRatio, 1)
+	return func() Policy[K] {
+		return &SLRU[K]{
+			probation:      newKeyList[K](),
+			protected:      newKeyList[K](),
+			protectedRatio: protectedRatio,
+		}
+	}
+}
+
+func (policy *SLRU[K]) Insert(key K) {
+	policy.probation.pushFront(key)
+}
+
+func (policy *SLRU[K]) Access(key K) {
+	if !policy.probation.remove(key) {
+		policy.protected.moveToFront(key)
+		return
+	}
+
+	policy.protected.pushFront(key)
+	limit := int(policy.protectedRatio * float64(policy.probation.len()+policy.protected.len()))
+	for policy.protected.len() > limit {
+		demoted, _ := policy.protected.back()
+		policy.protected.remove(demoted)
+		policy.probation.pushFront(demoted)
+	}
+}
+
+func (policy *SLRU[K]) Victim() (K, bool) {
+	if victim, ok := policy.probation.back(); ok {
+		return victim, true
+	}
+	return policy.protected.back()
+}
+
+func (policy *SLRU[K]) Remove(key K) {
+	if !policy.probation.remove(key) {
+		policy.protected.remove(key)
+	}
+}
+
+func (policy *SLRU[K]) Clear() {
+	policy.probation.clear()
+	policy.protected.clear()
+}
+
+func (policy *SLRU[K]) Segments() map[string]int {
+	return map[string]int{
+		"probation": policy.probation.len(),
+		"protected": policy.protected.len(),
+	}
+}
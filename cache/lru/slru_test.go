@@ -0,0 +1,36 @@
+package lru
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSLRUPromotesOnSecondUse(t *testing.T) {
+	policy := NewSLRU[string](0.5)()
+	policy.Insert("once")
+	policy.Insert("twice")
+	policy.Access("twice")
+	if victim, _ := policy.Victim(); victim != "once" {
+		t.Fatalf("Victim() = %q, want the key used once", victim)
+	}
+	if segments := policy.(Segmented).Segments(); segments["protected"] != 1 || segments["probation"] != 1 {
+		t.Fatalf("Segments() = %v", segments)
+	}
+}
+
+func TestSLRUClampsProtectedRatio(t *testing.T) {
+	for _, ratio := range []float64{-1, 0, 2, math.NaN(), math.Inf(1)} {
+		policy := NewSLRU[int](ratio)()
+		for key := range 10 {
+			policy.Insert(key)
+			policy.Access(key)
+		}
+		segments := policy.(Segmented).Segments()
+		if segments["probation"]+segments["protected"] != 10 {
+			t.Fatalf("ratio %v: Segments() = %v, want 10 keys", ratio, segments)
+		}
+		if ratio <= 0 && segments["protected"] != 0 {
+			t.Fatalf("ratio %v: %d keys protected, want none", ratio, segments["protected"])
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package lru
+
+import "hash/maphash"
+
+const (
+	// windowPercent is the share of entries kept in the admission window.
+	windowPercent = 1
+	// protectedPercent is the share of the main region that is protected.
+	protectedPercent = 80
+)
+
+// TinyLFU is the W-TinyLFU policy. New keys enter a small LRU window. When
+// the window overflows, its oldest key becomes a candidate for the main
+// region, a segmented LRU, and is only admitted if a count-min sketch says
+// it has been seen more often than the key main would evict for it. A scan
+// of keys that are used once therefore only churns the window and leaves
+// the hot working set in main alone.
+//
+// The region sizes follow the number of keys the policy tracks, so the
+// policy works the same way for entry and byte bounded caches.
+type TinyLFU[K comparable] struct {
+	window    keyList[K]
+	probation keyList[K]
+	protected keyList[K]
+	sketch    *countMinSketch
+	seed      maphash.Seed
+}
+
+func NewTinyLFU[K comparable]() Policy[K] {
+	return &TinyLFU[K]{
+		window:    newKeyList[K](),
+		probation: newKeyList[K](),
+		protected: newKeyList[K](),
+		sketch:    newCountMinSketch(0),
+		seed:      maphash.MakeSeed(),
+	}
+}
+
+func (policy *TinyLFU[K]) Insert(key K) {
+	policy.window.pushFront(key)
+	policy.grow()
+	policy.sketch.increment(policy.hash(key))
+	policy.trimWindow()
+}
+
+func (policy *TinyLFU[K]) Access(key K) {
+	policy.sketch.increment(policy.hash(key))
+
+	switch {
+	case policy.window.contains(key):
+		policy.window.moveToFront(key)
+	case policy.protected.contains(key):
+		policy.protected.moveToFront(key)
+	case policy.probation.remove(key):
+		policy.protected.pushFront(key)
+		// Demote rather than evict: the protected tail gets another chance
+		// in probation.
+		for policy.protected.len() > policy.protectedSize() {
+			demoted, _ := policy.protected.back()
+			policy.protected.remove(demoted)
+			policy.probation.pushFront(demoted)
+		}
+	}
+}
+
+// Victim is asked for before a new key is inserted, so a full window means
+// its oldest key is about to be pushed out and has to compete for main.
+func (policy *TinyLFU[K]) Victim() (K, bool) {
+	policy.trimWindow()
+
+	victim, ok := policy.mainVictim()
+	if !ok {
+		return policy.window.back()
+	}
+	if policy.window.len() < policy.windowSize() {
+		return victim, true
+	}
+
+	candidate, _ := policy.window.back()
+	if policy.sketch.estimate(policy.hash(candidate)) <= policy.sketch.estimate(policy.hash(victim)) {
+		return candidate, true
+	}
+	policy.window.remove(candidate)
+	policy.probation.pushFront(candidate)
+	return victim, true
+}
+
+func (policy *TinyLFU[K]) Remove(key K) {
+	if !policy.window.remove(key) && !policy.probation.remove(key) {
+		policy.protected.remove(key)
+	}
+}
+
+func (policy *TinyLFU[K]) Clear() {
+	policy.window.clear()
+	policy.probation.clear()
+	policy.protected.clear()
+	policy.sketch.clear()
+}
+
+func (policy *TinyLFU[K]) Segments() map[string]int {
+	return map[string]int{
+		"window":    policy.window.len(),
+		"probation": policy.probation.len(),
+		"protected": policy.protected.len(),
+	}
+}
+
+// trimWindow moves the oldest keys of the window into probation while it
+// holds more than its share. That happens while the cache fills up or after
+// keys in main were removed, and main then has room for them without anyone
+// competing.
+func (policy *TinyLFU[K]) trimWindow() {
+	for policy.window.len() > policy.windowSize() {
+		candidate, _ := policy.window.back()
+		policy.window.remove(candidate)
+		policy.probation.pushFront(candidate)
+	}
+}
+
+func (policy *TinyLFU[K]) mainVictim() (K, bool) {
+	if victim, ok := policy.probation.back(); ok {
+		return victim, true
+	}
+	return policy.protected.back()
+}
+
+func (policy *TinyLFU[K]) hash(key K) uint64 {
+	return maphash.Comparable(policy.seed, key)
+}
+
+func (policy *TinyLFU[K]) len() int {
+	return policy.window.len() + policy.probation.len() + policy.protected.len()
+}
+
+func (policy *TinyLFU[K]) windowSize() int {
+	if size := policy.len() * windowPercent / 100; size > 1 {
+		return size
+	}
+	return 1
+}
+
+func (policy *TinyLFU[K]) protectedSize() int {
+	return (policy.len() - policy.windowSize()) * protectedPercent / 100
+}
+
+// grow keeps the sketch at least twice as wide as the number of keys, so
+// collisions don't drown out the estimates. Doubling keeps the counts, so
+// the keys read while the cache fills up stay hot.
+func (policy *TinyLFU[K]) grow() {
+	for 2*policy.len() > policy.sketch.width() {
+		policy.sketch.double()
+	}
+}
@@ -0,0 +1,71 @@
+package lru
+
+import (
+	"strconv"
+	"testing"
+)
+
+// hotAfterScan fills a cache of 100 with 50 keys read 20 times each, scans
+// 1000 keys that are read once, and returns how many of the hot keys are
+// left.
+func hotAfterScan(newPolicy func() Policy[string]) int {
+	cache := New(100, WithPolicy[string, int](newPolicy))
+	for i := range 50 {
+		cache.Put("hot:"+strconv.Itoa(i), i)
+	}
+	for range 20 {
+		for i := range 50 {
+			cache.Get("hot:" + strconv.Itoa(i))
+		}
+	}
+	for i := range 1000 {
+		cache.Put("scan:"+strconv.Itoa(i), i)
+	}
+
+	left := 0
+	for i := range 50 {
+		if cache.Contains("hot:" + strconv.Itoa(i)) {
+			left++
+		}
+	}
+	return left
+}
+
+func TestTinyLFUResistsScans(t *testing.T) {
+	if left := hotAfterScan(NewTinyLFU[string]); left < 45 {
+		t.Fatalf("%d of 50 hot keys left after a scan, want at least 45", left)
+	}
+	if left := hotAfterScan(NewLRU[string]); left != 0 {
+		t.Fatalf("LRU kept %d hot keys through a scan", left)
+	}
+}
+
+func TestTinyLFUKeepsWindowShare(t *testing.T) {
+	policy := NewTinyLFU[int]()
+	for key := range 1000 {
+		policy.Insert(key)
+	}
+	segments := policy.(Segmented).Segments()
+	if segments["window"] != 1000*windowPercent/100 || segments["probation"]+segments["protected"] != 1000-segments["window"] {
+		t.Fatalf("Segments() = %v after 1000 inserts", segments)
+	}
+}
+
+func TestSketchKeepsCountsWhenDoubled(t *testing.T) {
+	sketch := newCountMinSketch(16)
+	for hash := range uint64(16) {
+		for range hash % maxCount {
+			sketch.increment(hash * 0x9e3779b97f4a7c15)
+		}
+	}
+	before := make([]uint8, 16)
+	for hash := range uint64(16) {
+		before[hash] = sketch.estimate(hash * 0x9e3779b97f4a7c15)
+	}
+	sketch.double()
+	for hash := range uint64(16) {
+		if estimate := sketch.estimate(hash * 0x9e3779b97f4a7c15); estimate != before[hash] {
+			t.Fatalf("estimate of %d = %d after double, was %d", hash, estimate, before[hash])
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package lru
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// WithBeta sets the beta factor of early refresh. Higher values refresh
+// earlier; zero turns early refresh off. The default is one.
+func WithBeta[K comparable, V any](beta float64) Option[K, V] {
+	return func(cache *Cache[K, V]) {
+		cache.beta = beta
+	}
+}
+
+// refreshDue decides whether the entry for key should be refreshed: always
+// once it is stale, and before that using XFetch (Vattani et al., "Optimal
+// Probabilistic Cache Stampede Prevention"): a read at time t refreshes the
+// entry if
+//
+//	t - delta * beta * ln(rand()) >= expiry
+//
+// where delta is how long the value took to compute. The chance grows as the
+// expiry comes closer, and sooner for values that are slow to compute, so
+// the entry is usually refreshed once by one reader instead of by all of
+// them when it expires. Entries already being refreshed are never due.
+func (cache *Cache[K, V]) refreshDue(key K) bool {
+	if _, _, ok := cache.inFlight(key); ok {
+		return false
+	}
+	i, ok := cache.elements[key]
+	if !ok {
+		return false
+	}
+	pair := cache.entries.at(i)
+	now := cache.clock.Now()
+	if pair.stale(now) {
+		return true
+	}
+	if cache.beta <= 0 || pair.expiresAt.IsZero() || pair.delta <= 0 {
+		return false
+	}
+	gap := time.Duration(float64(pair.delta) * cache.beta * -math.Log(1-rand.Float64()))
+	return !now.Add(gap).Before(pair.expiresAt)
+}
+
+// Fetched is what Fetch found for a key.
+type Fetched[V any] struct {
+	Value V
+	// Stale is set once the entry is past its soft TTL. It can still be
+	// served, for instance while the backend is down, until it expires.
+	Stale bool
+	// Token is a lease to refresh the entry, handed to one caller when the
+	// entry is stale or about to expire.
+	Token uint64
+	// Version is the version of the entry, to pass to CompareAndSet.
+	Version uint64
+}
+
+// Fetch is Get with refresh. When an entry is stale, or has a TTL and a
+// recorded recompute time and is due for early refresh, one caller gets a
+// lease token along with the value; it should compute a new value and pass
+// it to Fill within timeout. Everyone else keeps getting the cached value
+// meanwhile.
+func (sharded *Sharded[K, V]) Fetch(key K, timeout time.Duration) (Fetched[V], bool) {
+	s := sharded.shardFor(key)
+	s.Lock()
+	defer s.unlock()
+
+	pair, ok := s.cache.get(key)
+	if !ok {
+		return Fetched[V]{}, false
+	}
+	fetched := Fetched[V]{Value: pair.value, Stale: pair.stale(s.cache.clock.Now()), Version: pair.version}
+	if s.cache.refreshDue(key) {
+		fetched.Token = sharded.token()
+		s.cache.takeOff(key, fetched.Token, timeout)
+	}
+	return fetched, true
+}
@@ -0,0 +1,89 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFetchSoftTTL(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewSharded(0, 1, WithClock[string, string](clock))
+	cache.PutWithOptions("report", "v1", PutOptions{TTL: time.Hour, SoftTTL: time.Minute})
+
+	if fetched, ok := cache.Fetch("report", time.Second); !ok || fetched.Stale || fetched.Token != 0 {
+		t.Fatalf("Fetch = %+v, %v before the soft TTL, want a fresh hit", fetched, ok)
+	}
+
+	clock.advance(time.Minute)
+	first, ok := cache.Fetch("report", time.Second)
+	if !ok || !first.Stale || first.Value != "v1" || first.Token == 0 {
+		t.Fatalf("Fetch = %+v, %v past the soft TTL, want v1, stale, with a token", first, ok)
+	}
+	// Only the first reader is asked to refresh.
+	if second, _ := cache.Fetch("report", time.Second); !second.Stale || second.Token != 0 {
+		t.Fatalf("second Fetch = %+v, want stale without a token", second)
+	}
+
+	if _, err := cache.Fill("report", first.Token, "v2", PutOptions{TTL: time.Hour, SoftTTL: time.Minute}); err != nil {
+		t.Fatal(err)
+	}
+	if fetched, _ := cache.Fetch("report", time.Second); fetched.Stale || fetched.Value != "v2" {
+		t.Fatalf("Fetch = %+v after Fill, want fresh v2", fetched)
+	}
+}
+
+func TestSoftTTLIgnoredUnlessShorter(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewSharded(0, 1, WithClock[string, string](clock))
+	cache.PutWithOptions("report", "v1", PutOptions{TTL: time.Minute, SoftTTL: time.Hour})
+	cache.PutWithOptions("draft", "v1", PutOptions{SoftTTL: time.Minute})
+
+	clock.advance(59 * time.Second)
+	if fetched, _ := cache.Fetch("report", time.Second); fetched.Stale {
+		t.Fatal("an entry went stale on a soft TTL longer than its TTL")
+	}
+	clock.advance(time.Second)
+	if _, ok := cache.Fetch("report", time.Second); ok {
+		t.Fatal("Fetch returned an expired entry")
+	}
+	if fetched, ok := cache.Fetch("draft", time.Second); !ok || !fetched.Stale {
+		t.Fatalf("Fetch = %+v, %v, want a stale entry without a TTL", fetched, ok)
+	}
+}
+
+func TestRefreshDue(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		beta    float64
+		options PutOptions
+		after   time.Duration
+		due     bool
+	}{
+		{"slow value right before expiry", 1, PutOptions{TTL: time.Minute, RecomputeTime: 1000 * time.Hour}, 59 * time.Second, true},
+		{"fast value long before expiry", 1, PutOptions{TTL: time.Hour, RecomputeTime: time.Millisecond}, 0, false},
+		{"beta zero", 0, PutOptions{TTL: time.Minute, RecomputeTime: 1000 * time.Hour}, 59 * time.Second, false},
+		{"no recompute time", 1, PutOptions{TTL: time.Minute}, 59 * time.Second, false},
+		{"no ttl", 1, PutOptions{RecomputeTime: 1000 * time.Hour}, time.Hour, false},
+	} {
+		clock := newFakeClock()
+		cache := New(0, WithClock[string, string](clock), WithBeta[string, string](test.beta))
+		cache.PutWithOptions("report", "v1", test.options)
+		clock.advance(test.after)
+		if due := cache.refreshDue("report"); due != test.due {
+			t.Errorf("%s: refreshDue = %v, want %v", test.name, due, test.due)
+		}
+	}
+}
+
+func TestFetchLeasesEarlyRefreshOnce(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewSharded(0, 1, WithClock[string, string](clock))
+	cache.PutWithOptions("report", "v1", PutOptions{TTL: time.Minute, RecomputeTime: 1000 * time.Hour})
+	clock.advance(59 * time.Second)
+
+	first, _ := cache.Fetch("report", time.Second)
+	second, _ := cache.Fetch("report", time.Second)
+	if first.Token == 0 || second.Token != 0 || first.Stale {
+		t.Fatalf("Fetch = %+v then %+v, want one token for a fresh entry", first, second)
+	}
+}
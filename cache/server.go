@@ -5,6 +5,7 @@ import (
 	"cache/lru"
 	"cache/utils"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"google.golang.org/grpc"
@@ -13,10 +14,23 @@ import (
 	"log"
 	"net"
 	"strconv"
+	"time"
 )
 
-var capacity, _ = strconv.Atoi(utils.GetEnv("cache_capacity", "100"))
-var cache = lru.New(capacity)
+var capacity, _ = strconv.Atoi(utils.GetEnv("cache_capacity", "0"))
+var maxBytes, _ = strconv.ParseInt(utils.GetEnv("cache_max_bytes", "67108864"), 10, 64)
+var shards, _ = strconv.Atoi(utils.GetEnv("cache_shards", "16"))
+var policy = utils.GetEnv("cache_policy", "lru")
+var protectedRatio, _ = strconv.ParseFloat(utils.GetEnv("cache_slru_protected_ratio", "0.8"), 64)
+var cache *lru.Sharded[string, string]
+var sweepInterval, _ = time.ParseDuration(utils.GetEnv("cache_sweep_interval", "100ms"))
+var leaseTimeout, _ = time.ParseDuration(utils.GetEnv("cache_lease_timeout", "2s"))
+var leaseBackoff, _ = time.ParseDuration(utils.GetEnv("cache_lease_backoff", "50ms"))
+var beta, _ = strconv.ParseFloat(utils.GetEnv("cache_xfetch_beta", "1"), 64)
+var sliding, _ = strconv.ParseBool(utils.GetEnv("cache_sliding", "false"))
+var maxIdle, _ = time.ParseDuration(utils.GetEnv("cache_max_idle", "0s"))
+var pinnedCapacity, _ = strconv.Atoi(utils.GetEnv("cache_pinned_capacity", "1024"))
+var hashMaxFields, _ = strconv.Atoi(utils.GetEnv("cache_hash_max_fields", "256"))
 
 var (
 	port = flag.String(
@@ -29,51 +43,455 @@ var (
 	sslEnabled = utils.GetEnv("SSL_ENABLE", "true")
 )
 
+var policies = map[string]func() lru.Policy[string]{
+	"lru":     lru.NewLRU[string],
+	"lfu":     lru.NewLFU[string],
+	"fifo":    lru.NewFIFO[string],
+	"random":  lru.NewRandom[string],
+	"tinylfu": lru.NewTinyLFU[string],
+	"arc":     lru.NewARC[string],
+	"slru":    lru.NewSLRU[string](protectedRatio),
+	"gdsf":    lru.NewGDSF[string],
+}
+
+var priorities = map[pb.Priority]lru.Priority{
+	pb.Priority_LOW:    lru.Low,
+	pb.Priority_NORMAL: lru.Normal,
+	pb.Priority_HIGH:   lru.High,
+}
+
+var conditions = map[pb.SetMode]lru.Condition{
+	pb.SetMode_ALWAYS:     lru.Always,
+	pb.SetMode_IF_ABSENT:  lru.IfAbsent,
+	pb.SetMode_IF_PRESENT: lru.IfPresent,
+}
+
 type server struct {
 	pb.UnimplementedCacheHandlerServer
 }
 
 func (s *server) GetKey(_ context.Context, in *pb.GetKeyRequest) (*pb.GetKeyReply, error) {
 	log.Printf("Get Key: %s", in.Key)
-	if value, exists := cache.Get(in.Key); exists {
-		return &pb.GetKeyReply{Value: value}, nil
+	if simple != nil {
+		if value, exists := simple.Get(in.Key); exists {
+			return &pb.GetKeyReply{Value: value}, nil
+		}
+		return &pb.GetKeyReply{}, status.Errorf(404, "Key not found.")
+	}
+	if fetched, exists := cache.Fetch(in.Key, leaseTimeout); exists {
+		if lru.IsHash(fetched.Value) {
+			return &pb.GetKeyReply{}, status.Error(400, lru.ErrWrongType.Error())
+		}
+		return &pb.GetKeyReply{
+			Value:   fetched.Value,
+			Refresh: fetched.Token != 0,
+			Token:   fetched.Token,
+			Stale:   fetched.Stale,
+			Version: fetched.Version,
+		}, nil
 	} else {
-		return &pb.GetKeyReply{Value: value}, status.Errorf(404, "Key not found.")
+		return &pb.GetKeyReply{}, status.Errorf(404, "Key not found.")
 	}
 }
 
 func (s *server) SetKey(_ context.Context, in *pb.SetKeyRequest) (*pb.SetKeyReply, error) {
 	log.Printf("Set Key: %s -> %s", in.Key, in.Value)
 
+	options, err := putOptions(in)
+	if err != nil {
+		return &pb.SetKeyReply{}, err
+	}
+	if simple != nil {
+		if !plain(in) {
+			return &pb.SetKeyReply{}, status.Errorf(400, "only key and value are supported by the %s engine.", engine)
+		}
+		if err := simple.Put(in.Key, in.Value); err != nil {
+			return &pb.SetKeyReply{}, status.Error(400, err.Error())
+		}
+		return &pb.SetKeyReply{Applied: true}, nil
+	}
+	condition, ok := conditions[in.Mode]
+	if !ok {
+		return &pb.SetKeyReply{}, status.Error(400, "unknown mode.")
+	}
+	if in.Token != 0 {
+		if condition != lru.Always || in.ReturnPrevious {
+			return &pb.SetKeyReply{}, status.Error(400, "mode and return previous should not be set with a token.")
+		}
+		version, err := cache.Fill(in.Key, in.Token, in.Value, options)
+		if errors.Is(err, lru.ErrLeaseRevoked) {
+			return &pb.SetKeyReply{}, status.Error(409, err.Error())
+		} else if err != nil {
+			return &pb.SetKeyReply{}, status.Error(400, err.Error())
+		}
+		return &pb.SetKeyReply{Version: version, Applied: true}, nil
+	}
+	var written lru.Written[string]
+	if in.ReturnPrevious {
+		written, err = lru.PutIfNotHash(cache, in.Key, in.Value, condition, options)
+	} else {
+		written, err = cache.PutIf(in.Key, in.Value, condition, options)
+	}
+	if err != nil {
+		return &pb.SetKeyReply{}, status.Error(400, err.Error())
+	}
+	reply := &pb.SetKeyReply{Version: written.Version, Applied: written.Applied}
+	if in.ReturnPrevious && written.Existed {
+		reply.Previous = &written.Previous
+	}
+	return reply, nil
+}
+
+// CompareAndSet sets the key only if it is still at the expected version, so
+// that writers racing each other cannot overwrite a newer value with an older
+// one. A mismatch fails with 412 and the current version in the message.
+func (s *server) CompareAndSet(_ context.Context, in *pb.CompareAndSetRequest) (*pb.CompareAndSetReply, error) {
+	if in.Entry == nil {
+		return &pb.CompareAndSetReply{}, status.Error(400, "entry should be set.")
+	}
+	log.Printf("Compare And Set Key: %s -> %s at version %d", in.Entry.Key, in.Entry.Value, in.ExpectedVersion)
+
+	options, err := putOptions(in.Entry)
+	if err != nil {
+		return &pb.CompareAndSetReply{}, err
+	}
+	if in.Entry.Token != 0 || in.Entry.Mode != pb.SetMode_ALWAYS {
+		return &pb.CompareAndSetReply{}, status.Error(400, "token and mode should not be set in a compare and set.")
+	}
+	version, err := cache.CompareAndSet(in.Entry.Key, in.Entry.Value, in.ExpectedVersion, options)
+	if errors.Is(err, lru.ErrVersionMismatch) {
+		return &pb.CompareAndSetReply{}, status.Error(412, err.Error())
+	} else if err != nil {
+		return &pb.CompareAndSetReply{}, status.Error(400, err.Error())
+	}
+	return &pb.CompareAndSetReply{Version: version}, nil
+}
+
+// putOptions validates a SetKeyRequest and turns it into the options of the
+// put, filling in the server defaults.
+func putOptions(in *pb.SetKeyRequest) (lru.PutOptions, error) {
 	if len(in.Key) > 64 {
-		return &pb.SetKeyReply{}, status.Error(400, "key should be less than 64 character.")
+		return lru.PutOptions{}, status.Error(400, "key should be less than 64 character.")
 	}
 	if len(in.Value) > 2048 {
-		return &pb.SetKeyReply{}, status.Error(400, "Value should be less than 1024 character.")
+		return lru.PutOptions{}, status.Error(400, "Value should be less than 1024 character.")
+	}
+	if lru.IsHash(in.Value) {
+		return lru.PutOptions{}, status.Error(400, "value should not start with a zero byte.")
+	}
+	if in.Ttl < 0 || in.SoftTtl < 0 {
+		return lru.PutOptions{}, status.Error(400, "ttl should not be negative.")
+	}
+	if in.Cost < 0 {
+		return lru.PutOptions{}, status.Error(400, "cost should not be negative.")
+	}
+	if in.RecomputeTime < 0 {
+		return lru.PutOptions{}, status.Error(400, "recompute time should not be negative.")
+	}
+	if in.GetMaxIdle() < 0 {
+		return lru.PutOptions{}, status.Error(400, "max idle should not be negative.")
+	}
+	priority, ok := priorities[in.Priority]
+	if !ok {
+		return lru.PutOptions{}, status.Error(400, "unknown priority.")
+	}
+
+	options := lru.PutOptions{
+		TTL:           time.Duration(in.Ttl) * time.Millisecond,
+		SoftTTL:       time.Duration(in.SoftTtl) * time.Millisecond,
+		Cost:          in.Cost,
+		RecomputeTime: time.Duration(in.RecomputeTime) * time.Millisecond,
+		Sliding:       sliding,
+		MaxIdle:       maxIdle,
+		Pinned:        in.Pinned,
+		Priority:      priority,
+	}
+	if in.Sliding != nil {
+		options.Sliding = *in.Sliding
+	}
+	if in.MaxIdle != nil {
+		options.MaxIdle = time.Duration(*in.MaxIdle) * time.Millisecond
+	}
+	return options, nil
+}
+
+func (s *server) Incr(_ context.Context, in *pb.IncrRequest) (*pb.CounterReply, error) {
+	log.Printf("Incr Key: %s", in.Key)
+	return incrBy(in.Key, 1, in.Ttl)
+}
+
+func (s *server) IncrBy(_ context.Context, in *pb.IncrByRequest) (*pb.CounterReply, error) {
+	log.Printf("Incr Key: %s by %d", in.Key, in.Delta)
+	return incrBy(in.Key, in.Delta, in.Ttl)
+}
+
+func (s *server) Decr(_ context.Context, in *pb.DecrRequest) (*pb.CounterReply, error) {
+	log.Printf("Decr Key: %s", in.Key)
+	return incrBy(in.Key, -1, in.Ttl)
+}
+
+// incrBy adds delta to the counter under key, creating it with the ttl in
+// milliseconds if it is missing. The remaining ttl is rounded up, so a live
+// counter with a ttl never reports zero.
+func incrBy(key string, delta int64, ttl int64) (*pb.CounterReply, error) {
+	if len(key) > 64 {
+		return &pb.CounterReply{}, status.Error(400, "key should be less than 64 character.")
+	}
+	if ttl < 0 {
+		return &pb.CounterReply{}, status.Error(400, "ttl should not be negative.")
+	}
+	value, left, err := lru.IncrBy(cache, key, delta, lru.PutOptions{TTL: time.Duration(ttl) * time.Millisecond})
+	if err != nil {
+		return &pb.CounterReply{}, status.Error(400, err.Error())
+	}
+	return &pb.CounterReply{Value: value, Ttl: int64((left + time.Millisecond - 1) / time.Millisecond)}, nil
+}
+
+func (s *server) HSet(_ context.Context, in *pb.HSetRequest) (*pb.HSetReply, error) {
+	log.Printf("Set Hash: %s -> %v", in.Key, in.Fields)
+	for field, value := range in.Fields {
+		if err := checkField(in.Key, field, value); err != nil {
+			return &pb.HSetReply{}, err
+		}
+	}
+	if in.Ttl < 0 {
+		return &pb.HSetReply{}, status.Error(400, "ttl should not be negative.")
+	}
+	added, err := lru.HSet(cache, in.Key, in.Fields, lru.PutOptions{TTL: time.Duration(in.Ttl) * time.Millisecond})
+	if err != nil {
+		return &pb.HSetReply{}, status.Error(400, err.Error())
+	}
+	return &pb.HSetReply{Added: int64(added)}, nil
+}
+
+func (s *server) HGet(_ context.Context, in *pb.HGetRequest) (*pb.HGetReply, error) {
+	log.Printf("Get Hash: %s %s", in.Key, in.Field)
+	value, found, err := lru.HGet(cache, in.Key, in.Field)
+	if err != nil {
+		return &pb.HGetReply{}, status.Error(400, err.Error())
+	}
+	return &pb.HGetReply{Found: found, Value: value}, nil
+}
+
+func (s *server) HMGet(_ context.Context, in *pb.HMGetRequest) (*pb.HMGetReply, error) {
+	log.Printf("Get Hash: %s %v", in.Key, in.Fields)
+	values, found, err := lru.HMGet(cache, in.Key, in.Fields)
+	if err != nil {
+		return &pb.HMGetReply{}, status.Error(400, err.Error())
+	}
+	results := make([]*pb.HMGetReply_Result, len(in.Fields))
+	for i, field := range in.Fields {
+		results[i] = &pb.HMGetReply_Result{Field: field, Found: found[i], Value: values[i]}
+	}
+	return &pb.HMGetReply{Results: results}, nil
+}
+
+func (s *server) HDel(_ context.Context, in *pb.HDelRequest) (*pb.HDelReply, error) {
+	log.Printf("Remove Hash Fields: %s %v", in.Key, in.Fields)
+	removed, err := lru.HDel(cache, in.Key, in.Fields)
+	if err != nil {
+		return &pb.HDelReply{}, status.Error(400, err.Error())
+	}
+	return &pb.HDelReply{Removed: int64(removed)}, nil
+}
+
+func (s *server) HGetAll(_ context.Context, in *pb.HGetAllRequest) (*pb.HGetAllReply, error) {
+	log.Printf("Get Hash: %s", in.Key)
+	fields, err := lru.HGetAll(cache, in.Key)
+	if err != nil {
+		return &pb.HGetAllReply{}, status.Error(400, err.Error())
+	}
+	return &pb.HGetAllReply{Fields: fields}, nil
+}
+
+func (s *server) HIncrBy(_ context.Context, in *pb.HIncrByRequest) (*pb.HIncrByReply, error) {
+	log.Printf("Incr Hash: %s %s by %d", in.Key, in.Field, in.Delta)
+	if err := checkField(in.Key, in.Field, ""); err != nil {
+		return &pb.HIncrByReply{}, err
+	}
+	if in.Ttl < 0 {
+		return &pb.HIncrByReply{}, status.Error(400, "ttl should not be negative.")
+	}
+	value, err := lru.HIncrBy(cache, in.Key, in.Field, in.Delta, lru.PutOptions{TTL: time.Duration(in.Ttl) * time.Millisecond})
+	if err != nil {
+		return &pb.HIncrByReply{}, status.Error(400, err.Error())
+	}
+	return &pb.HIncrByReply{Value: value}, nil
+}
+
+// checkField validates a field written to a hash with the limits of SetKey.
+func checkField(key, field, value string) error {
+	if len(key) > 64 || len(field) > 64 {
+		return status.Error(400, "key and field should be less than 64 character.")
+	}
+	if len(value) > 2048 {
+		return status.Error(400, "Value should be less than 1024 character.")
+	}
+	return nil
+}
+
+func (s *server) MGet(_ context.Context, in *pb.MGetRequest) (*pb.MGetReply, error) {
+	log.Printf("Get Keys: %v", in.Keys)
+	values, found := cache.MGet(in.Keys)
+	results := make([]*pb.MGetReply_Result, len(in.Keys))
+	for i, key := range in.Keys {
+		results[i] = &pb.MGetReply_Result{Key: key, Found: found[i], Value: values[i]}
+		if found[i] && lru.IsHash(values[i]) {
+			results[i].Value, results[i].Code, results[i].Error = "", 400, lru.ErrWrongType.Error()
+		}
+	}
+	return &pb.MGetReply{Results: results}, nil
+}
+
+// MSet stores the valid entries in one batch. Invalid entries, including
+// ones with a lease token or a mode, are skipped and reported in their
+// result.
+func (s *server) MSet(_ context.Context, in *pb.MSetRequest) (*pb.MSetReply, error) {
+	log.Printf("Set Keys: %d entries", len(in.Entries))
+	results := make([]*pb.MSetReply_Result, len(in.Entries))
+	var entries []lru.Entry[string, string]
+	var batched []int
+	for i, entry := range in.Entries {
+		results[i] = &pb.MSetReply_Result{Key: entry.Key}
+		options, err := putOptions(entry)
+		if err == nil && (entry.Token != 0 || entry.Mode != pb.SetMode_ALWAYS) {
+			err = status.Error(400, "token and mode should not be set in a batch.")
+		}
+		if err != nil {
+			results[i].Code = uint32(status.Code(err))
+			results[i].Error = status.Convert(err).Message()
+			continue
+		}
+		entries = append(entries, lru.Entry[string, string]{Key: entry.Key, Value: entry.Value, Options: options})
+		batched = append(batched, i)
+	}
+
+	versions, errs := cache.MSet(entries)
+	for j, err := range errs {
+		if err != nil {
+			results[batched[j]].Code = 400
+			results[batched[j]].Error = err.Error()
+		}
+		results[batched[j]].Version = versions[j]
 	}
+	return &pb.MSetReply{Results: results}, nil
+}
+
+func (s *server) MDelete(_ context.Context, in *pb.MDeleteRequest) (*pb.MDeleteReply, error) {
+	log.Printf("Remove Keys: %v", in.Keys)
+	removed := cache.MDelete(in.Keys)
+	results := make([]*pb.MDeleteReply_Result, len(in.Keys))
+	for i, key := range in.Keys {
+		results[i] = &pb.MDeleteReply_Result{Key: key, Found: removed[i]}
+	}
+	return &pb.MDeleteReply{Results: results}, nil
+}
+
+func (s *server) Lease(ctx context.Context, in *pb.LeaseRequest) (*pb.LeaseReply, error) {
+	log.Printf("Lease Key: %s", in.Key)
+	if in.Wait < 0 {
+		return &pb.LeaseReply{}, status.Error(400, "wait should not be negative.")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(in.Wait)*time.Millisecond)
+	defer cancel()
+	value, token, err := cache.Lease(ctx, in.Key, leaseTimeout)
+	switch {
+	case errors.Is(err, lru.ErrLeaseHeld):
+		return &pb.LeaseReply{Status: pb.LeaseReply_BACKOFF, RetryAfter: leaseBackoff.Milliseconds()}, nil
+	case err != nil:
+		return &pb.LeaseReply{}, status.Error(500, err.Error())
+	case token != 0:
+		return &pb.LeaseReply{Status: pb.LeaseReply_FILL, Token: token}, nil
+	case lru.IsHash(value):
+		return &pb.LeaseReply{}, status.Error(400, lru.ErrWrongType.Error())
+	}
+	return &pb.LeaseReply{Status: pb.LeaseReply_HIT, Value: value}, nil
+}
+
+func (s *server) ListKeys(_ context.Context, in *pb.ListKeysRequest) (*pb.ListKeysReply, error) {
+	log.Printf("List Keys: %s", in.Prefix)
+	if in.Limit < 0 {
+		return &pb.ListKeysReply{}, status.Error(400, "limit should not be negative.")
+	}
+
+	var keys []string
+	cache.RangePrefix(in.Prefix, func(key string, _ string) bool {
+		keys = append(keys, key)
+		return in.Limit == 0 || int64(len(keys)) < in.Limit
+	})
+	return &pb.ListKeysReply{Keys: keys, Count: int64(cache.CountPrefix(in.Prefix))}, nil
+}
+
+func (s *server) Pin(_ context.Context, in *pb.PinRequest) (*pb.PinReply, error) {
+	log.Printf("Pin Key: %s", in.Key)
+	if err := cache.Pin(in.Key); errors.Is(err, lru.ErrNotFound) {
+		return &pb.PinReply{}, status.Errorf(404, "Key not found.")
+	} else if err != nil {
+		return &pb.PinReply{}, status.Error(400, err.Error())
+	}
+	return &pb.PinReply{}, nil
+}
 
-	cache.Put(in.Key, in.Value)
-	return &pb.SetKeyReply{}, nil
+func (s *server) Unpin(_ context.Context, in *pb.UnpinRequest) (*pb.UnpinReply, error) {
+	log.Printf("Unpin Key: %s", in.Key)
+	if err := cache.Unpin(in.Key); err != nil {
+		return &pb.UnpinReply{}, status.Errorf(404, "Key not found.")
+	}
+	return &pb.UnpinReply{}, nil
 }
 
 func (s *server) Clear(_ context.Context, _ *pb.ClearRequest) (*pb.ClearReply, error) {
 	log.Printf("Clear Cache")
+	if simple != nil {
+		simple.Clear()
+		return &pb.ClearReply{}, nil
+	}
 	cache.Clear()
 	return &pb.ClearReply{}, nil
 }
 
 func (s *server) Remove(_ context.Context, in *pb.RemoveKeyRequest) (*pb.RemoveKeyReply, error) {
 	log.Printf("Remove Key: %s", in.Key)
+	if simple != nil {
+		simple.Remove(in.Key)
+		return &pb.RemoveKeyReply{}, nil
+	}
 	cache.Remove(in.Key)
 	return &pb.RemoveKeyReply{}, nil
 }
 
+func (s *server) Stats(_ context.Context, _ *pb.StatsRequest) (*pb.StatsReply, error) {
+	var stats lru.Stats
+	name := policy
+	if simple != nil {
+		stats, name = simple.Stats(), engine
+	} else {
+		stats = cache.Stats()
+	}
+	segments := make(map[string]int64, len(stats.Segments))
+	for segment, keys := range stats.Segments {
+		segments[segment] = int64(keys)
+	}
+	return &pb.StatsReply{
+		Hits:      stats.Hits,
+		Misses:    stats.Misses,
+		Evictions: stats.Evictions,
+		Entries:   int64(stats.Entries),
+		Bytes:     stats.Bytes,
+		HitRatio:  stats.HitRatio(),
+		Policy:    name,
+		Segments:  segments,
+		Pinned:    int64(stats.Pinned),
+	}, nil
+}
+
 func initServer(transportCredentials credentials.TransportCredentials) *grpc.Server {
 	log.Printf("ssl: %s", sslEnabled)
 	if sslEnabled == "true" {
-		return grpc.NewServer(grpc.Creds(transportCredentials))
+		return grpc.NewServer(grpc.Creds(transportCredentials), grpc.UnaryInterceptor(engineInterceptor))
 	} else {
-		return grpc.NewServer()
+		return grpc.NewServer(grpc.UnaryInterceptor(engineInterceptor))
 	}
 }
 
@@ -84,6 +502,43 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
+	newPolicy, ok := policies[policy]
+	if !ok {
+		log.Fatalf("unknown cache policy: %s", policy)
+	}
+	if protectedRatio < 0 || protectedRatio > 1 {
+		log.Fatalf("cache_slru_protected_ratio should be between 0 and 1: %v", protectedRatio)
+	}
+	if engine == "sharded" {
+		cache = lru.NewSharded(capacity, shards,
+			lru.WithMaxBytes[string, string](maxBytes),
+			lru.WithPolicy[string, string](newPolicy),
+			lru.WithBeta[string, string](beta),
+			lru.WithPrefixIndex[string, string](),
+			lru.WithPinnedCapacity[string, string](pinnedCapacity),
+			lru.WithMaxHashFields[string, string](hashMaxFields))
+		log.Printf("cache policy: %s", policy)
+		cache.OnEvict(func(key string, _ string, reason lru.EvictionReason) {
+			if reason == lru.Capacity || reason == lru.Expired {
+				log.Printf("Evict Key: %s (%s)", key, reason)
+			}
+		})
+
+		if sweepInterval > 0 {
+			stopSweeper := cache.StartSweeper(sweepInterval)
+			defer stopSweeper()
+		}
+	} else {
+		newStore, ok := engines[engine]
+		if !ok {
+			log.Fatalf("unknown cache engine: %s", engine)
+		}
+		if simple, err = newStore(); err != nil {
+			log.Fatalf("%v", err)
+		}
+		log.Printf("cache engine: %s", engine)
+	}
+
 	s := initServer(transportCredentials)
 	pb.RegisterCacheHandlerServer(s, &server{})
 	log.Printf("server listening at %v", lis.Addr())